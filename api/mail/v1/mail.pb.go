@@ -312,6 +312,210 @@ func (m *Empty) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Empty proto.InternalMessageInfo
 
+type MagicLinkRequest struct {
+	Email                string   `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	Token                string   `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	LoginChallenge       string   `protobuf:"bytes,3,opt,name=login_challenge,json=loginChallenge,proto3" json:"login_challenge,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MagicLinkRequest) Reset()         { *m = MagicLinkRequest{} }
+func (m *MagicLinkRequest) String() string { return proto.CompactTextString(m) }
+func (*MagicLinkRequest) ProtoMessage()    {}
+func (*MagicLinkRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_37fe58669483f1c9, []int{6}
+}
+
+func (m *MagicLinkRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MagicLinkRequest.Unmarshal(m, b)
+}
+func (m *MagicLinkRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MagicLinkRequest.Marshal(b, m, deterministic)
+}
+func (m *MagicLinkRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MagicLinkRequest.Merge(m, src)
+}
+func (m *MagicLinkRequest) XXX_Size() int {
+	return xxx_messageInfo_MagicLinkRequest.Size(m)
+}
+func (m *MagicLinkRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MagicLinkRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MagicLinkRequest proto.InternalMessageInfo
+
+func (m *MagicLinkRequest) GetEmail() string {
+	if m != nil {
+		return m.Email
+	}
+	return ""
+}
+
+func (m *MagicLinkRequest) GetToken() string {
+	if m != nil {
+		return m.Token
+	}
+	return ""
+}
+
+func (m *MagicLinkRequest) GetLoginChallenge() string {
+	if m != nil {
+		return m.LoginChallenge
+	}
+	return ""
+}
+
+type LoginCodeRequest struct {
+	Email                string   `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	Code                 string   `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LoginCodeRequest) Reset()         { *m = LoginCodeRequest{} }
+func (m *LoginCodeRequest) String() string { return proto.CompactTextString(m) }
+func (*LoginCodeRequest) ProtoMessage()    {}
+func (*LoginCodeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_37fe58669483f1c9, []int{7}
+}
+
+func (m *LoginCodeRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LoginCodeRequest.Unmarshal(m, b)
+}
+func (m *LoginCodeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LoginCodeRequest.Marshal(b, m, deterministic)
+}
+func (m *LoginCodeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LoginCodeRequest.Merge(m, src)
+}
+func (m *LoginCodeRequest) XXX_Size() int {
+	return xxx_messageInfo_LoginCodeRequest.Size(m)
+}
+func (m *LoginCodeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_LoginCodeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LoginCodeRequest proto.InternalMessageInfo
+
+func (m *LoginCodeRequest) GetEmail() string {
+	if m != nil {
+		return m.Email
+	}
+	return ""
+}
+
+func (m *LoginCodeRequest) GetCode() string {
+	if m != nil {
+		return m.Code
+	}
+	return ""
+}
+
+type EmailChangeVerificationRequest struct {
+	Email                string   `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	Token                string   `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EmailChangeVerificationRequest) Reset()         { *m = EmailChangeVerificationRequest{} }
+func (m *EmailChangeVerificationRequest) String() string { return proto.CompactTextString(m) }
+func (*EmailChangeVerificationRequest) ProtoMessage()    {}
+func (*EmailChangeVerificationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_37fe58669483f1c9, []int{8}
+}
+
+func (m *EmailChangeVerificationRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EmailChangeVerificationRequest.Unmarshal(m, b)
+}
+func (m *EmailChangeVerificationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EmailChangeVerificationRequest.Marshal(b, m, deterministic)
+}
+func (m *EmailChangeVerificationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EmailChangeVerificationRequest.Merge(m, src)
+}
+func (m *EmailChangeVerificationRequest) XXX_Size() int {
+	return xxx_messageInfo_EmailChangeVerificationRequest.Size(m)
+}
+func (m *EmailChangeVerificationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_EmailChangeVerificationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EmailChangeVerificationRequest proto.InternalMessageInfo
+
+func (m *EmailChangeVerificationRequest) GetEmail() string {
+	if m != nil {
+		return m.Email
+	}
+	return ""
+}
+
+func (m *EmailChangeVerificationRequest) GetToken() string {
+	if m != nil {
+		return m.Token
+	}
+	return ""
+}
+
+type EmailChangeNotificationRequest struct {
+	Email                string   `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	NewEmail             string   `protobuf:"bytes,2,opt,name=new_email,json=newEmail,proto3" json:"new_email,omitempty"`
+	RevertToken          string   `protobuf:"bytes,3,opt,name=revert_token,json=revertToken,proto3" json:"revert_token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EmailChangeNotificationRequest) Reset()         { *m = EmailChangeNotificationRequest{} }
+func (m *EmailChangeNotificationRequest) String() string { return proto.CompactTextString(m) }
+func (*EmailChangeNotificationRequest) ProtoMessage()    {}
+func (*EmailChangeNotificationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_37fe58669483f1c9, []int{9}
+}
+
+func (m *EmailChangeNotificationRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EmailChangeNotificationRequest.Unmarshal(m, b)
+}
+func (m *EmailChangeNotificationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EmailChangeNotificationRequest.Marshal(b, m, deterministic)
+}
+func (m *EmailChangeNotificationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EmailChangeNotificationRequest.Merge(m, src)
+}
+func (m *EmailChangeNotificationRequest) XXX_Size() int {
+	return xxx_messageInfo_EmailChangeNotificationRequest.Size(m)
+}
+func (m *EmailChangeNotificationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_EmailChangeNotificationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EmailChangeNotificationRequest proto.InternalMessageInfo
+
+func (m *EmailChangeNotificationRequest) GetEmail() string {
+	if m != nil {
+		return m.Email
+	}
+	return ""
+}
+
+func (m *EmailChangeNotificationRequest) GetNewEmail() string {
+	if m != nil {
+		return m.NewEmail
+	}
+	return ""
+}
+
+func (m *EmailChangeNotificationRequest) GetRevertToken() string {
+	if m != nil {
+		return m.RevertToken
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterEnum("api.v1.mail.EmailResponse_ServingStatus", EmailResponse_ServingStatus_name, EmailResponse_ServingStatus_value)
 	proto.RegisterType((*EmailResponse)(nil), "api.v1.mail.EmailResponse")
@@ -320,40 +524,54 @@ func init() {
 	proto.RegisterType((*EmailRequest)(nil), "api.v1.mail.EmailRequest")
 	proto.RegisterType((*PaymentRequest)(nil), "api.v1.mail.PaymentRequest")
 	proto.RegisterType((*Empty)(nil), "api.v1.mail.Empty")
+	proto.RegisterType((*MagicLinkRequest)(nil), "api.v1.mail.MagicLinkRequest")
+	proto.RegisterType((*LoginCodeRequest)(nil), "api.v1.mail.LoginCodeRequest")
+	proto.RegisterType((*EmailChangeVerificationRequest)(nil), "api.v1.mail.EmailChangeVerificationRequest")
+	proto.RegisterType((*EmailChangeNotificationRequest)(nil), "api.v1.mail.EmailChangeNotificationRequest")
 }
 
 func init() { proto.RegisterFile("mail/v1/mail.proto", fileDescriptor_37fe58669483f1c9) }
 
 var fileDescriptor_37fe58669483f1c9 = []byte{
-	// 445 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x53, 0xc1, 0x6e, 0xd3, 0x40,
-	0x10, 0x6d, 0x5a, 0x9a, 0x88, 0x71, 0x5b, 0xd2, 0xa1, 0x12, 0x21, 0x80, 0x80, 0x15, 0x12, 0xbd,
-	0xe0, 0xa8, 0xe5, 0xc4, 0xad, 0x25, 0x35, 0x28, 0x52, 0x1b, 0xaa, 0x75, 0xa8, 0x10, 0x97, 0xb0,
-	0x38, 0x53, 0x6b, 0x45, 0xb2, 0x6b, 0xbc, 0x9b, 0xa0, 0xfe, 0x03, 0xdf, 0xc4, 0xb7, 0xa1, 0x5d,
-	0xbb, 0xc2, 0x16, 0x69, 0x2a, 0xf5, 0x64, 0xcd, 0xcc, 0x7b, 0x6f, 0x9e, 0xe7, 0x69, 0x01, 0x67,
-	0x42, 0x4e, 0x7b, 0x8b, 0x83, 0x9e, 0xfb, 0x86, 0x59, 0xae, 0xad, 0xc6, 0x40, 0x64, 0x32, 0x5c,
-	0x1c, 0x84, 0xae, 0xd5, 0x7d, 0x9a, 0x6a, 0x9d, 0x4e, 0xa9, 0x27, 0x32, 0xd9, 0x13, 0x4a, 0x69,
-	0x2b, 0xac, 0xd4, 0xca, 0x14, 0x50, 0xf6, 0xbb, 0x01, 0xdb, 0x91, 0xc3, 0x71, 0x32, 0x99, 0x56,
-	0x86, 0xf0, 0x08, 0x9a, 0xc6, 0x0a, 0x3b, 0x37, 0x9d, 0xc6, 0x8b, 0xc6, 0xfe, 0xce, 0xe1, 0x7e,
-	0x58, 0x51, 0x0b, 0x6b, 0xd8, 0x30, 0xa6, 0x7c, 0x21, 0x55, 0x1a, 0x7b, 0x3c, 0x2f, 0x79, 0xec,
-	0x1d, 0x6c, 0xd7, 0x06, 0x18, 0x40, 0x2b, 0xfe, 0xdc, 0xef, 0x47, 0x71, 0xdc, 0x5e, 0x43, 0x80,
-	0xe6, 0x87, 0xe3, 0xc1, 0x69, 0x74, 0xd2, 0x6e, 0xe0, 0x03, 0x08, 0x86, 0x9f, 0x46, 0xe3, 0x38,
-	0xe2, 0x17, 0x83, 0xe1, 0xc7, 0xf6, 0x3a, 0x13, 0xd0, 0x3d, 0x4e, 0x12, 0x3d, 0x57, 0xf6, 0x82,
-	0x72, 0x79, 0x29, 0x13, 0x6f, 0x96, 0xd3, 0xcf, 0x39, 0x19, 0x8b, 0x7b, 0xb0, 0x49, 0x6e, 0xbf,
-	0x77, 0x76, 0x9f, 0x17, 0x05, 0xbe, 0x01, 0x5c, 0x54, 0xc0, 0x63, 0xab, 0x7f, 0x90, 0xea, 0xac,
-	0x7b, 0xc8, 0x6e, 0x75, 0x32, 0x72, 0x03, 0x96, 0xc0, 0x1e, 0x27, 0x43, 0xf6, 0x5c, 0x18, 0xf3,
-	0x4b, 0xe7, 0x93, 0x8a, 0x78, 0xc1, 0x2c, 0xc5, 0x7d, 0x81, 0xcf, 0x21, 0xc8, 0x4a, 0xe0, 0x58,
-	0x4e, 0x4a, 0x55, 0xb8, 0x6e, 0x0d, 0x26, 0xff, 0x3c, 0x6d, 0x54, 0x3c, 0xb1, 0x57, 0xb0, 0x55,
-	0x5e, 0x6a, 0x85, 0x73, 0x76, 0x04, 0x3b, 0xe7, 0xe2, 0x6a, 0x46, 0xca, 0xae, 0xfe, 0xc3, 0x0e,
-	0xb4, 0xb2, 0x02, 0xe7, 0x0d, 0x6c, 0xf1, 0xeb, 0x92, 0xb5, 0x60, 0x33, 0x9a, 0x65, 0xf6, 0xea,
-	0xf0, 0xcf, 0x06, 0x04, 0x67, 0x42, 0x4e, 0xfd, 0xe1, 0x13, 0xc2, 0x21, 0x3c, 0xe4, 0x94, 0x4a,
-	0x63, 0x29, 0x77, 0x6d, 0xa9, 0xd2, 0x53, 0x69, 0x2c, 0x3e, 0x5e, 0x16, 0xa6, 0x5f, 0xdd, 0xed,
-	0xde, 0x9c, 0x33, 0x5b, 0xc3, 0x6f, 0xf0, 0x28, 0x26, 0x35, 0x59, 0x12, 0x0e, 0xbe, 0xae, 0x11,
-	0x6f, 0x8e, 0xef, 0x96, 0x0d, 0x23, 0xd8, 0x75, 0x1b, 0x6a, 0xd9, 0xe0, 0xcb, 0x1a, 0x65, 0x59,
-	0x6e, 0xb7, 0xa8, 0x7e, 0x81, 0x67, 0xff, 0xa9, 0xf6, 0xb5, 0xba, 0x94, 0xf9, 0xac, 0x70, 0x7f,
-	0xe7, 0x8b, 0x9c, 0x01, 0x3a, 0xe5, 0x32, 0xc0, 0x13, 0x4a, 0xa6, 0x52, 0x11, 0x3e, 0xa9, 0x71,
-	0xea, 0xe9, 0xae, 0x12, 0x7c, 0xdf, 0xfc, 0x7a, 0xcf, 0xd5, 0xdf, 0x9b, 0xfe, 0x5d, 0xbe, 0xfd,
-	0x1b, 0x00, 0x00, 0xff, 0xff, 0x71, 0xe8, 0xbf, 0x9a, 0xd8, 0x03, 0x00, 0x00,
+	// 608 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x95, 0xef, 0x4f, 0xd3, 0x40,
+	0x18, 0xc7, 0x19, 0xc2, 0x90, 0xa7, 0xfc, 0x18, 0x27, 0x89, 0x38, 0x44, 0xe5, 0x62, 0x02, 0x89,
+	0xb1, 0x0b, 0xf8, 0xca, 0xc4, 0x17, 0xe0, 0x98, 0x06, 0x33, 0x26, 0x69, 0x91, 0x18, 0xdf, 0xcc,
+	0xb3, 0x7d, 0x28, 0x17, 0xba, 0xbb, 0xda, 0xde, 0xba, 0xf0, 0x3f, 0xf8, 0x77, 0xf8, 0x77, 0x9a,
+	0x5e, 0xbb, 0xd9, 0x8e, 0xad, 0x4c, 0x5f, 0xc1, 0x3d, 0xf7, 0x7d, 0x3e, 0xcf, 0xf7, 0x6e, 0xf7,
+	0xdd, 0x80, 0xf4, 0x18, 0xf7, 0x1b, 0xf1, 0x41, 0x23, 0xf9, 0x6b, 0x06, 0xa1, 0x54, 0x92, 0x18,
+	0x2c, 0xe0, 0x66, 0x7c, 0x60, 0x26, 0xa5, 0xfa, 0x53, 0x4f, 0x4a, 0xcf, 0xc7, 0x06, 0x0b, 0x78,
+	0x83, 0x09, 0x21, 0x15, 0x53, 0x5c, 0x8a, 0x28, 0x95, 0xd2, 0x5f, 0x15, 0x58, 0x6d, 0x25, 0x3a,
+	0x0b, 0xa3, 0x40, 0x8a, 0x08, 0xc9, 0x11, 0x54, 0x23, 0xc5, 0x54, 0x3f, 0xda, 0xaa, 0xbc, 0xa8,
+	0xec, 0xaf, 0x1d, 0xee, 0x9b, 0x39, 0x9a, 0x59, 0xd0, 0x9a, 0x36, 0x86, 0x31, 0x17, 0x9e, 0xad,
+	0xf5, 0x56, 0xd6, 0x47, 0xdf, 0xc2, 0x6a, 0x61, 0x83, 0x18, 0xb0, 0x64, 0x7f, 0x69, 0x36, 0x5b,
+	0xb6, 0x5d, 0x9b, 0x23, 0x00, 0xd5, 0x0f, 0xc7, 0xa7, 0xed, 0xd6, 0x49, 0xad, 0x42, 0xd6, 0xc1,
+	0xe8, 0x7c, 0xbe, 0xe8, 0xda, 0x2d, 0xeb, 0xf2, 0xb4, 0xf3, 0xb1, 0x36, 0x4f, 0x19, 0xd4, 0x8f,
+	0x1d, 0x47, 0xf6, 0x85, 0xba, 0xc4, 0x90, 0x5f, 0x71, 0x47, 0x9b, 0xb5, 0xf0, 0x67, 0x1f, 0x23,
+	0x45, 0x36, 0x61, 0x11, 0x93, 0xf9, 0xda, 0xd9, 0xb2, 0x95, 0x2e, 0xc8, 0x6b, 0x20, 0x71, 0x4e,
+	0xdc, 0x55, 0xf2, 0x06, 0xc5, 0xd6, 0xbc, 0x96, 0x6c, 0xe4, 0x77, 0x2e, 0x92, 0x0d, 0xea, 0xc0,
+	0xa6, 0x85, 0x11, 0xaa, 0x73, 0x16, 0x45, 0x03, 0x19, 0xba, 0x39, 0x78, 0xda, 0x99, 0xc1, 0xf5,
+	0x82, 0x3c, 0x07, 0x23, 0xc8, 0x84, 0x5d, 0xee, 0x66, 0x54, 0x18, 0x96, 0x4e, 0xdd, 0xbf, 0x9e,
+	0x1e, 0xe4, 0x3c, 0xd1, 0x97, 0xb0, 0x92, 0xdd, 0x54, 0x89, 0x73, 0x7a, 0x04, 0x6b, 0xe7, 0xec,
+	0xb6, 0x87, 0x42, 0x95, 0x9f, 0x70, 0x0b, 0x96, 0x82, 0x54, 0xa7, 0x0d, 0xac, 0x58, 0xc3, 0x25,
+	0x5d, 0x82, 0xc5, 0x56, 0x2f, 0x50, 0xb7, 0xd4, 0x83, 0xda, 0x19, 0xf3, 0xb8, 0xd3, 0xe6, 0xe2,
+	0xa6, 0x1c, 0x36, 0x3a, 0xe7, 0x7c, 0xfe, 0x9c, 0x7b, 0xb0, 0xee, 0x4b, 0x8f, 0x8b, 0xae, 0x73,
+	0xcd, 0x7c, 0x1f, 0x85, 0x87, 0xd9, 0x81, 0xd6, 0x74, 0xb9, 0x39, 0xac, 0xd2, 0x77, 0x50, 0x6b,
+	0xeb, 0x8a, 0x74, 0xb1, 0x7c, 0x10, 0x81, 0x05, 0x47, 0xba, 0x98, 0xcd, 0xd1, 0xff, 0xd3, 0x36,
+	0x3c, 0xd3, 0xf7, 0xd2, 0xbc, 0x66, 0xc2, 0xc3, 0xd9, 0x3f, 0xe3, 0x89, 0xa6, 0x69, 0x5c, 0xa0,
+	0x75, 0xa4, 0x9a, 0x91, 0xb6, 0x0d, 0xcb, 0x02, 0x07, 0xdd, 0x74, 0x27, 0x25, 0x3e, 0x14, 0x38,
+	0xd0, 0x2c, 0xb2, 0x0b, 0x2b, 0x21, 0xc6, 0x18, 0xaa, 0xec, 0x21, 0xa5, 0xd7, 0x60, 0xa4, 0x35,
+	0xfd, 0x84, 0x0e, 0x7f, 0x57, 0xc1, 0x38, 0x63, 0xdc, 0xd7, 0xaf, 0xdc, 0x41, 0xd2, 0x81, 0x47,
+	0x16, 0x7a, 0x3c, 0x52, 0x18, 0x26, 0x65, 0x2e, 0xbc, 0x36, 0x8f, 0x14, 0x79, 0x32, 0x29, 0x39,
+	0xda, 0x57, 0xbd, 0x3e, 0x3d, 0x54, 0x74, 0x8e, 0x7c, 0x87, 0xc7, 0x36, 0x0a, 0x77, 0x42, 0x12,
+	0xc8, 0x5e, 0xa1, 0x71, 0x7a, 0x56, 0xee, 0x99, 0x70, 0x01, 0x1b, 0xc9, 0x84, 0x42, 0x10, 0xc8,
+	0x6e, 0xa1, 0x65, 0x52, 0x48, 0xee, 0xa1, 0x7e, 0x85, 0x9d, 0x3b, 0xd4, 0xa6, 0x14, 0x57, 0x3c,
+	0xec, 0xa5, 0xee, 0xff, 0xfb, 0x46, 0xce, 0x80, 0x24, 0xe4, 0x2c, 0x2d, 0x27, 0xe8, 0xf8, 0x5c,
+	0x20, 0xd9, 0x2e, 0xf4, 0x14, 0xa3, 0x54, 0x06, 0x24, 0x9f, 0x92, 0x6f, 0x28, 0xe1, 0x8e, 0x12,
+	0x43, 0x76, 0x0a, 0xe2, 0xf1, 0x24, 0xcd, 0xc2, 0x1a, 0x85, 0x62, 0x8c, 0x35, 0x1e, 0x96, 0x52,
+	0xd6, 0x15, 0x6c, 0x27, 0xac, 0x29, 0x11, 0x21, 0xaf, 0xee, 0xb6, 0x4e, 0x0d, 0xd2, 0x3f, 0xce,
+	0xc9, 0x87, 0x67, 0xfa, 0x9c, 0x09, 0x11, 0x2b, 0x9b, 0xf3, 0xbe, 0xfa, 0x6d, 0x21, 0x59, 0xff,
+	0xa8, 0xea, 0x1f, 0x9b, 0x37, 0x7f, 0x06, 0x00, 0x54, 0x37, 0xa2, 0x21, 0xad, 0x06, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -373,6 +591,10 @@ type MailServiceClient interface {
 	SendResetPassword(ctx context.Context, in *ResetPasswordRequest, opts ...grpc.CallOption) (*EmailResponse, error)
 	SendResetPasswordConfirmation(ctx context.Context, in *EmailRequest, opts ...grpc.CallOption) (*EmailResponse, error)
 	SendPaymentDecline(ctx context.Context, in *PaymentRequest, opts ...grpc.CallOption) (*EmailResponse, error)
+	SendMagicLink(ctx context.Context, in *MagicLinkRequest, opts ...grpc.CallOption) (*EmailResponse, error)
+	SendLoginCode(ctx context.Context, in *LoginCodeRequest, opts ...grpc.CallOption) (*EmailResponse, error)
+	SendEmailChangeVerification(ctx context.Context, in *EmailChangeVerificationRequest, opts ...grpc.CallOption) (*EmailResponse, error)
+	SendEmailChangeNotification(ctx context.Context, in *EmailChangeNotificationRequest, opts ...grpc.CallOption) (*EmailResponse, error)
 }
 
 type mailServiceClient struct {
@@ -428,6 +650,42 @@ func (c *mailServiceClient) SendPaymentDecline(ctx context.Context, in *PaymentR
 	return out, nil
 }
 
+func (c *mailServiceClient) SendMagicLink(ctx context.Context, in *MagicLinkRequest, opts ...grpc.CallOption) (*EmailResponse, error) {
+	out := new(EmailResponse)
+	err := c.cc.Invoke(ctx, "/api.v1.mail.MailService/SendMagicLink", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mailServiceClient) SendLoginCode(ctx context.Context, in *LoginCodeRequest, opts ...grpc.CallOption) (*EmailResponse, error) {
+	out := new(EmailResponse)
+	err := c.cc.Invoke(ctx, "/api.v1.mail.MailService/SendLoginCode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mailServiceClient) SendEmailChangeVerification(ctx context.Context, in *EmailChangeVerificationRequest, opts ...grpc.CallOption) (*EmailResponse, error) {
+	out := new(EmailResponse)
+	err := c.cc.Invoke(ctx, "/api.v1.mail.MailService/SendEmailChangeVerification", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mailServiceClient) SendEmailChangeNotification(ctx context.Context, in *EmailChangeNotificationRequest, opts ...grpc.CallOption) (*EmailResponse, error) {
+	out := new(EmailResponse)
+	err := c.cc.Invoke(ctx, "/api.v1.mail.MailService/SendEmailChangeNotification", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MailServiceServer is the server API for MailService service.
 type MailServiceServer interface {
 	RegisterMailingList(context.Context, *EmailRequest) (*EmailResponse, error)
@@ -435,6 +693,10 @@ type MailServiceServer interface {
 	SendResetPassword(context.Context, *ResetPasswordRequest) (*EmailResponse, error)
 	SendResetPasswordConfirmation(context.Context, *EmailRequest) (*EmailResponse, error)
 	SendPaymentDecline(context.Context, *PaymentRequest) (*EmailResponse, error)
+	SendMagicLink(context.Context, *MagicLinkRequest) (*EmailResponse, error)
+	SendLoginCode(context.Context, *LoginCodeRequest) (*EmailResponse, error)
+	SendEmailChangeVerification(context.Context, *EmailChangeVerificationRequest) (*EmailResponse, error)
+	SendEmailChangeNotification(context.Context, *EmailChangeNotificationRequest) (*EmailResponse, error)
 }
 
 // UnimplementedMailServiceServer can be embedded to have forward compatible implementations.
@@ -456,6 +718,18 @@ func (*UnimplementedMailServiceServer) SendResetPasswordConfirmation(ctx context
 func (*UnimplementedMailServiceServer) SendPaymentDecline(ctx context.Context, req *PaymentRequest) (*EmailResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SendPaymentDecline not implemented")
 }
+func (*UnimplementedMailServiceServer) SendMagicLink(ctx context.Context, req *MagicLinkRequest) (*EmailResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendMagicLink not implemented")
+}
+func (*UnimplementedMailServiceServer) SendLoginCode(ctx context.Context, req *LoginCodeRequest) (*EmailResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendLoginCode not implemented")
+}
+func (*UnimplementedMailServiceServer) SendEmailChangeVerification(ctx context.Context, req *EmailChangeVerificationRequest) (*EmailResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendEmailChangeVerification not implemented")
+}
+func (*UnimplementedMailServiceServer) SendEmailChangeNotification(ctx context.Context, req *EmailChangeNotificationRequest) (*EmailResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendEmailChangeNotification not implemented")
+}
 
 func RegisterMailServiceServer(s *grpc.Server, srv MailServiceServer) {
 	s.RegisterService(&_MailService_serviceDesc, srv)
@@ -551,6 +825,78 @@ func _MailService_SendPaymentDecline_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MailService_SendMagicLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MagicLinkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MailServiceServer).SendMagicLink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.v1.mail.MailService/SendMagicLink",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MailServiceServer).SendMagicLink(ctx, req.(*MagicLinkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MailService_SendLoginCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoginCodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MailServiceServer).SendLoginCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.v1.mail.MailService/SendLoginCode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MailServiceServer).SendLoginCode(ctx, req.(*LoginCodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MailService_SendEmailChangeVerification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmailChangeVerificationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MailServiceServer).SendEmailChangeVerification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.v1.mail.MailService/SendEmailChangeVerification",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MailServiceServer).SendEmailChangeVerification(ctx, req.(*EmailChangeVerificationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MailService_SendEmailChangeNotification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmailChangeNotificationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MailServiceServer).SendEmailChangeNotification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.v1.mail.MailService/SendEmailChangeNotification",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MailServiceServer).SendEmailChangeNotification(ctx, req.(*EmailChangeNotificationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _MailService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "api.v1.mail.MailService",
 	HandlerType: (*MailServiceServer)(nil),
@@ -575,6 +921,22 @@ var _MailService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SendPaymentDecline",
 			Handler:    _MailService_SendPaymentDecline_Handler,
 		},
+		{
+			MethodName: "SendMagicLink",
+			Handler:    _MailService_SendMagicLink_Handler,
+		},
+		{
+			MethodName: "SendLoginCode",
+			Handler:    _MailService_SendLoginCode_Handler,
+		},
+		{
+			MethodName: "SendEmailChangeVerification",
+			Handler:    _MailService_SendEmailChangeVerification_Handler,
+		},
+		{
+			MethodName: "SendEmailChangeNotification",
+			Handler:    _MailService_SendEmailChangeNotification_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "mail/v1/mail.proto",
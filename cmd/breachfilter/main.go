@@ -0,0 +1,89 @@
+// Command breachfilter builds the bloom filter read by the accounts service
+// when BREACH_CORPUS_FORMAT is "bloom" from a downloaded breached password
+// dump such as the Pwned Passwords SHA-1 list.
+//
+//	breachfilter -in pwned-passwords-sha1.txt -out breach.bloom -p 0.001
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/isaiahwong/accounts-go/internal/breach"
+)
+
+func main() {
+	in := flag.String("in", "", "dump of SHA-1 digests, one per line optionally followed by :count")
+	out := flag.String("out", "breach.bloom", "filter to write")
+	n := flag.Uint64("n", 0, "number of digests in the dump, counted if 0")
+	p := flag.Float64("p", 0.001, "false positive rate")
+	flag.Parse()
+
+	if *in == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+	if err := run(*in, *out, *n, *p); err != nil {
+		fmt.Fprintf(os.Stderr, "breachfilter: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func run(in, out string, n uint64, p float64) error {
+	if p <= 0 || p >= 1 {
+		return fmt.Errorf("false positive rate must be between 0 and 1")
+	}
+	f, err := os.Open(in)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	if n == 0 {
+		if n, err = countLines(f); err != nil {
+			return err
+		}
+		if _, err := f.Seek(0, io.SeekStart); err != nil {
+			return err
+		}
+	}
+
+	b, added, err := breach.Build(bufio.NewReaderSize(f, 1<<20), n, p)
+	if err != nil {
+		return err
+	}
+
+	o, err := os.Create(out)
+	if err != nil {
+		return err
+	}
+	size, err := b.WriteTo(o)
+	if err != nil {
+		o.Close()
+		return err
+	}
+	if err := o.Close(); err != nil {
+		return err
+	}
+	fmt.Printf("wrote %v: %d digests, %d bytes\n", out, added, size)
+	return nil
+}
+
+func countLines(r io.Reader) (uint64, error) {
+	var n uint64
+	buf := make([]byte, 1<<20)
+	for {
+		c, err := r.Read(buf)
+		n += uint64(bytes.Count(buf[:c], []byte{'\n'}))
+		if err == io.EOF {
+			return n, nil
+		}
+		if err != nil {
+			return 0, err
+		}
+	}
+}
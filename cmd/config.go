@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/isaiahwong/accounts-go/internal/common"
+	"github.com/isaiahwong/accounts-go/internal/ratelimit"
 	"github.com/joho/godotenv"
 )
 
@@ -29,6 +30,7 @@ import (
 // DBName
 // DBUser
 // DBPassword
+// RateLimits specifies the per method rate limits, RATE_LIMITS as JSON
 type EnvConfig struct {
 	AppEnv           string
 	Production       bool
@@ -40,6 +42,7 @@ type EnvConfig struct {
 	DBPassword       string
 	DBTimeout        time.Duration
 	DBInitialTimeout time.Duration
+	RateLimits       ratelimit.Config
 }
 
 // LoadEnv loads environment variables for Application
@@ -64,6 +67,14 @@ func loadEnv() *EnvConfig {
 	}
 	initialTimeout := time.Duration(sec) * time.Second
 
+	rateLimits := ratelimit.DefaultConfig()
+	if v := common.MapEnvWithDefaults("RATE_LIMITS", ""); v != "" {
+		rateLimits, err = ratelimit.ParseConfig([]byte(v))
+		if err != nil {
+			fmt.Printf("Error parsing RATE_LIMITS: %v\nWill fallback to default value", err)
+		}
+	}
+
 	return &EnvConfig{
 		AppEnv:           common.MapEnvWithDefaults("APP_ENV", "development"),
 		Production:       common.MapEnvWithDefaults("APP_ENV", "development") == "production",
@@ -74,5 +85,6 @@ func loadEnv() *EnvConfig {
 		DBPassword:       common.MapEnvWithDefaults("MONGO_PASSWORD", ""),
 		DBTimeout:        dBTimeout,
 		DBInitialTimeout: initialTimeout,
+		RateLimits:       rateLimits,
 	}
 }
@@ -3,6 +3,7 @@ package cmd
 import (
 	accounts "github.com/isaiahwong/accounts-go/internal/accounts"
 	"github.com/isaiahwong/accounts-go/internal/common/log"
+	"github.com/isaiahwong/accounts-go/internal/ratelimit"
 	"github.com/isaiahwong/accounts-go/internal/server"
 	"github.com/isaiahwong/accounts-go/internal/store/drivers/mongo"
 )
@@ -41,6 +42,7 @@ func init() {
 		server.WithLogger(l),
 		server.WithName("Accounts Service"),
 		server.WithDataStore(m),
+		server.WithRateLimiter(ratelimit.New(ratelimit.NewMemoryBackend(), config.RateLimits, l)),
 	)
 	s.Production = config.Production
 
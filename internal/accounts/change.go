@@ -0,0 +1,173 @@
+package accounts
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	accountsV1 "github.com/isaiahwong/accounts-go/api/accounts/v1"
+	mailV1 "github.com/isaiahwong/accounts-go/api/mail/v1"
+	"github.com/isaiahwong/accounts-go/internal/common/validator"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ChangePassword is a gRPC handler that replaces the password of the
+// account the bearer token was issued to given its current password. Wrong
+// current passwords count towards the account's lockout.
+func (s *Service) ChangePassword(ctx context.Context, req *accountsV1.ChangePasswordRequest) (*accountsV1.Empty, error) {
+	api := "ChangePassword: "
+
+	current := strings.TrimSpace(req.GetCurrentPassword())
+	password := s.passwordPolicy.Normalize(strings.TrimSpace(req.GetPassword()))
+	cpassword := s.passwordPolicy.Normalize(strings.TrimSpace(req.GetConfirmPassword()))
+
+	errs := validator.Val(
+		s.validate,
+		validator.Field{
+			Param:          "current_password",
+			Message:        "Current password required",
+			Value:          current,
+			Tag:            `required`,
+			OmitParamValue: true,
+		},
+		validator.Field{
+			Param:          "confirm_password",
+			Message:        "Passwords do not match",
+			Value:          cpassword,
+			OtherValue:     password,
+			Tag:            `eqfield`,
+			OmitParamValue: true,
+		},
+	)
+	errs = append(errs, s.checkPassword(password)...)
+	token, err := s.validateBearer(ctx, &api, errs...)
+	if err != nil {
+		return nil, err
+	}
+
+	u, introspection, err := s.accountFromToken(ctx, token, api)
+	if err != nil {
+		return nil, err
+	}
+	if u.Auth.Password == "" {
+		return nil, status.Error(codes.FailedPrecondition, "Account has no password. Use password reset to set one")
+	}
+	if time.Now().Before(u.Lockout.LockedUntil) {
+		return nil, s.lockedError(ctx, u.Lockout.LockedUntil, api)
+	}
+
+	ok, _, err := s.verifyPassword(u, current)
+	if err != nil {
+		s.logger.Errorf("%v: %v", api, err)
+		return nil, status.Error(codes.Internal, "An Internal error has occurred")
+	}
+	if !ok {
+		locked, until, err := s.recordFailedLogin(u, api)
+		if err != nil {
+			s.logger.Errorf("%v: %v", api, err)
+			return nil, status.Error(codes.Internal, "An Internal error has occurred")
+		}
+		if locked {
+			return nil, s.lockedError(ctx, until, api)
+		}
+		return nil, s.returnErrors(ctx, []validator.Error{
+			{
+				Param:   "current_password",
+				Message: "Wrong password",
+			},
+		}, codes.PermissionDenied, "Wrong password", api)
+	}
+	if err := s.resetFailedLogins(u); err != nil {
+		s.logger.Errorf("%v: %v", api, err)
+		return nil, status.Error(codes.Internal, "An Internal error has occurred")
+	}
+
+	if errs := s.checkPassword(password, u.Auth.Email, u.Auth.FirstName, u.Auth.LastName); len(errs) > 0 {
+		return nil, s.returnErrors(ctx, errs, codes.InvalidArgument, "Malformed request", api)
+	}
+	// Reject passwords known from breaches
+	if err := s.screenPassword(ctx, password, api); err != nil {
+		return nil, err
+	}
+	if err := s.checkPasswordReuse(ctx, u, password, api); err != nil {
+		return nil, err
+	}
+
+	// Hash password
+	hash, method, err := s.hashers.Hash(password)
+	if err != nil {
+		s.logger.Errorf("%v: %v", api, err)
+		return nil, status.Error(codes.Internal, "An Internal error has occurred")
+	}
+
+	// Match on the verified hash so concurrent changes cannot both succeed
+	_, err = s.accountsRepo.Update(
+		nil,
+		bson.M{
+			"_id":           u.ID,
+			"auth.password": u.Auth.Password,
+		},
+		bson.M{
+			"$set": bson.M{
+				"updated_at":                time.Now(),
+				"auth.password":             hash,
+				"auth.password_hash_method": method,
+				"auth.password_modified":    time.Now(),
+				"auth.password_history":     s.nextPasswordHistory(u),
+			},
+		},
+	)
+	if err == mongo.ErrNoDocuments {
+		return nil, status.Error(codes.Aborted, "Password has changed. Please try again")
+	}
+	if err != nil {
+		s.logger.Errorf("%v: %v", api, err)
+		return nil, status.Error(codes.Internal, "An Internal error has occurred")
+	}
+
+	if req.GetRevokeOtherSessions() {
+		if err := s.revokeOtherSessions(u.ID.Hex(), introspection.ClientID); err != nil {
+			s.logger.Errorf("%v: revoke sessions: %v", api, err)
+			return nil, status.Error(codes.Internal, "Password was changed but other sessions could not be revoked")
+		}
+	}
+
+	// The password has already been changed at this point so a failure to
+	// notify should not fail the request
+	_, err = s.mailSVC.SendResetPasswordConfirmation(ctx, &mailV1.EmailRequest{Email: u.Auth.Email})
+	if err != nil {
+		s.logger.Errorf("%v: mailSVC SendResetPasswordConfirmation: %v", api, err)
+	}
+
+	return &accountsV1.Empty{}, nil
+}
+
+// revokeOtherSessions revokes the subject's Hydra login sessions so other
+// browsers must log in again, and its consent to every client other than
+// the one named, revoking the tokens those clients hold. Hydra cannot tell
+// apart the sessions of a single client so the caller's client keeps its
+// tokens.
+func (s *Service) revokeOtherSessions(subject, client string) error {
+	if err := s.oAuthClient.RevokeLoginSessions(subject); err != nil {
+		return err
+	}
+	sessions, err := s.oAuthClient.ConsentSessions(subject)
+	if err != nil {
+		return err
+	}
+	revoked := map[string]bool{client: true}
+	for _, c := range sessions {
+		id := c.ConsentRequest.Client.ClientID
+		if revoked[id] {
+			continue
+		}
+		if err := s.oAuthClient.RevokeConsentSessions(subject, id); err != nil {
+			return err
+		}
+		revoked[id] = true
+	}
+	return nil
+}
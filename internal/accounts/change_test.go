@@ -0,0 +1,138 @@
+package accounts
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	pb "github.com/isaiahwong/accounts-go/api/accounts/v1"
+	"github.com/isaiahwong/accounts-go/internal/common/password"
+	"github.com/isaiahwong/accounts-go/internal/models"
+	"github.com/isaiahwong/accounts-go/internal/oauth"
+	"github.com/isaiahwong/accounts-go/tests/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestChangePassword(t *testing.T) {
+	hash, method, _ := testHashers.Hash(validPasswords[0])
+	acc := &models.Account{
+		ID: primitive.NewObjectID(),
+		Auth: models.Auth{
+			Email:              "isaiah@example.com",
+			Password:           hash,
+			PasswordHashMethod: method,
+			Verified:           true,
+		},
+	}
+
+	var revoked []string
+	hydra, srv := setupHydra(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/oauth2/introspect":
+			json.NewEncoder(w).Encode(oauth.InstrospectResponse{Active: true, Sub: acc.ID.Hex(), ClientID: "web"})
+		case r.URL.Path == "/oauth2/auth/sessions/login" && r.Method == "DELETE":
+			revoked = append(revoked, "login:"+r.URL.Query().Get("subject"))
+			w.WriteHeader(http.StatusNoContent)
+		case r.URL.Path == "/oauth2/auth/sessions/consent" && r.Method == "GET":
+			sessions := make([]oauth.HydraConsentSession, 3)
+			for i, c := range []string{"web", "mobile", "mobile"} {
+				sessions[i].ConsentRequest.Client.ClientID = c
+			}
+			json.NewEncoder(w).Encode(sessions)
+		case r.URL.Path == "/oauth2/auth/sessions/consent" && r.Method == "DELETE":
+			revoked = append(revoked, "consent:"+r.URL.Query().Get("client"))
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+	defer srv.Close()
+
+	// Apply password updates to acc
+	repo := new(mocks.Repo)
+	repo.On("GetTimeout").Return(time.Second)
+	repo.On("FindOne", mock.Anything, mock.Anything).Return(acc, nil)
+	repo.On("Update", nil, mock.Anything, mock.Anything).Return(1, func(_ context.Context, filter interface{}, update interface{}) error {
+		if p, ok := filter.(bson.M)["auth.password"]; ok && p != acc.Auth.Password {
+			return mongo.ErrNoDocuments
+		}
+		set := update.(bson.M)["$set"].(bson.M)
+		if v, ok := set["auth.password"]; ok {
+			acc.Auth.Password = v.(string)
+			acc.Auth.PasswordHashMethod = set["auth.password_hash_method"].(string)
+			acc.Auth.PasswordModified = set["auth.password_modified"].(time.Time)
+			acc.Auth.PasswordHistory = set["auth.password_history"].([]models.PreviousPassword)
+		}
+		return nil
+	})
+
+	mail := &mailStub{}
+	svc := &Service{
+		logger:          logger,
+		accountsRepo:    repo,
+		hashers:         testHashers,
+		passwordPolicy:  password.DefaultPolicy(),
+		passwordHistory: 3,
+		oAuthClient:     hydra,
+		mailSVC:         mail,
+	}
+	svc.initValidator()
+	ctx := incomingContext(
+		XForwardedFor, "127.0.0.1",
+		Authorization, "Bearer token",
+	)
+	change := func(current, pw string, revoke bool) error {
+		_, err := svc.ChangePassword(ctx, &pb.ChangePasswordRequest{
+			CurrentPassword:     current,
+			Password:            pw,
+			ConfirmPassword:     pw,
+			RevokeOtherSessions: revoke,
+		})
+		return err
+	}
+
+	t.Run("Malformed", func(t *testing.T) {
+		err := change("", "short", false)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		_, err = svc.ChangePassword(incomingContext(XForwardedFor, "127.0.0.1"), &pb.ChangePasswordRequest{})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("Wrong password", func(t *testing.T) {
+		err := change("wrong1234^", validPasswords[1], false)
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+		assert.Equal(t, hash, acc.Auth.Password)
+	})
+
+	t.Run("Reused password", func(t *testing.T) {
+		err := change(validPasswords[0], validPasswords[0], false)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		assert.Equal(t, hash, acc.Auth.Password)
+	})
+
+	t.Run("Changed", func(t *testing.T) {
+		err := change(validPasswords[0], validPasswords[1], true)
+		assert.NoError(t, err)
+		ok, _, _ := testHashers.Verify(validPasswords[1], acc.Auth.Password, acc.Auth.PasswordHashMethod)
+		assert.True(t, ok)
+		assert.False(t, acc.Auth.PasswordModified.IsZero())
+		assert.Equal(t, hash, acc.Auth.PasswordHistory[0].Hash)
+		assert.Equal(t, []string{"login:" + acc.ID.Hex(), "consent:mobile"}, revoked)
+		assert.Equal(t, []string{"SendResetPasswordConfirmation"}, mail.sent)
+	})
+
+	t.Run("Without revocation", func(t *testing.T) {
+		revoked = nil
+		err := change(validPasswords[1], "another1234^", false)
+		assert.NoError(t, err)
+		assert.Empty(t, revoked)
+	})
+}
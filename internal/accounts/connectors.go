@@ -0,0 +1,113 @@
+package accounts
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	accountsV1 "github.com/isaiahwong/accounts-go/api/accounts/v1"
+	"github.com/isaiahwong/accounts-go/internal/connectors"
+	"github.com/isaiahwong/accounts-go/internal/models"
+	"go.mongodb.org/mongo-driver/bson"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// StartFederatedLogin is a gRPC handler that returns the authorization URL
+// of a configured OpenID Connect connector for a login challenge
+func (s *Service) StartFederatedLogin(ctx context.Context, req *accountsV1.StartFederatedLoginRequest) (*accountsV1.FederatedLoginResponse, error) {
+	api := "StartFederatedLogin: "
+
+	c, err := s.connectors.Get(strings.TrimSpace(req.GetConnector()))
+	if err != nil {
+		return nil, status.Error(codes.NotFound, "Connector not found")
+	}
+	_, state, nonce, err := s.startFederatedRequest(ctx, c.ID(), &api)
+	if err != nil {
+		return nil, err
+	}
+
+	u, err := c.AuthCodeURL(ctx, state, nonce)
+	if err != nil {
+		s.logger.Errorf("%v: %v discovery: %v", api, c.ID(), err)
+		return nil, status.Error(codes.Unavailable, "Identity provider is unavailable")
+	}
+
+	return &accountsV1.FederatedLoginResponse{
+		RedirectTo: u,
+		State:      state,
+	}, nil
+}
+
+// CompleteFederatedLogin is a gRPC handler that exchanges the authorization
+// code returned to a connector's callback, signs in or creates the account
+// linked to the upstream identity and accepts the login challenge
+func (s *Service) CompleteFederatedLogin(ctx context.Context, req *accountsV1.CompleteFederatedLoginRequest) (*accountsV1.RedirectResponse, error) {
+	api := "CompleteFederatedLogin: "
+
+	c, err := s.connectors.Get(strings.TrimSpace(req.GetConnector()))
+	if err != nil {
+		return nil, status.Error(codes.NotFound, "Connector not found")
+	}
+	ip, challenge, code, nonce, err := s.completeFederatedRequest(ctx, req, c.ID(), &api)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := c.Login(ctx, code, nonce)
+	if err != nil {
+		s.logger.Errorf("%v: %v: %v", api, c.ID(), err)
+		return nil, status.Error(codes.PermissionDenied, "Federated login failed")
+	}
+
+	u, err := s.linkFederatedAccount(ctx, identityLink(id), ip, api)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.completeLogin(ctx, challenge, u, api)
+}
+
+// identityLink returns the link for an upstream identity stored in the
+// account's identities, keyed by issuer and subject
+func identityLink(id *connectors.Identity) *federatedLink {
+	now := time.Now()
+	identity := models.Identity{
+		Issuer:    id.Issuer,
+		Subject:   id.Subject,
+		Connector: id.Connector,
+		Email:     id.Email,
+		Linked:    now,
+		LastLogin: now,
+	}
+
+	l := &federatedLink{
+		filter: bson.M{
+			"identities": bson.M{"$elemMatch": bson.M{"issuer": id.Issuer, "subject": id.Subject}},
+		},
+		refresh: bson.M{"$set": bson.M{
+			"updated_at":              now,
+			"identities.$.email":      id.Email,
+			"identities.$.last_login": now,
+		}},
+		link: func(u *models.Account) bson.M {
+			// identities is null on accounts which never linked one
+			if len(u.Identities) == 0 {
+				return bson.M{"$set": bson.M{"identities": []models.Identity{identity}}}
+			}
+			return bson.M{"$push": bson.M{"identities": identity}}
+		},
+		attach: func(u *models.Account) {
+			u.Identities = []models.Identity{identity}
+		},
+		email:     id.Email,
+		verified:  id.EmailVerified,
+		firstname: id.GivenName,
+		lastname:  id.FamilyName,
+		picture:   id.Picture,
+	}
+	if l.firstname == "" && l.lastname == "" {
+		l.firstname = id.Name
+	}
+	return l
+}
@@ -0,0 +1,165 @@
+package accounts
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	pb "github.com/isaiahwong/accounts-go/api/accounts/v1"
+	"github.com/isaiahwong/accounts-go/internal/connectors"
+	"github.com/isaiahwong/accounts-go/internal/models"
+	"github.com/isaiahwong/accounts-go/internal/oauth"
+	"github.com/isaiahwong/accounts-go/internal/oidc/oidctest"
+	"github.com/isaiahwong/accounts-go/tests/mocks"
+	"github.com/microcosm-cc/bluemonday"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestFederatedLogin(t *testing.T) {
+	idp := oidctest.NewServer("client", "secret")
+	defer idp.Close()
+
+	var accepted *oauth.HydraLoginAccept
+	hydra, srv := setupHydra(func(w http.ResponseWriter, r *http.Request) {
+		accepted = &oauth.HydraLoginAccept{}
+		json.NewDecoder(r.Body).Decode(accepted)
+		json.NewEncoder(w).Encode(oauth.HydraRedirect{RedirectTo: "https://example.com/callback"})
+	})
+	defer srv.Close()
+
+	registry, err := connectors.NewRegistry([]connectors.Config{
+		{
+			ID:           "corp",
+			DiscoveryURL: idp.URL + "/.well-known/openid-configuration",
+			ClientID:     "client",
+			ClientSecret: "secret",
+			RedirectURL:  "https://example.com/corp/callback",
+		},
+	})
+	assert.NoError(t, err)
+
+	newService := func(repo *mocks.Repo) *Service {
+		svc := &Service{
+			logger:        logger,
+			policy:        bluemonday.StrictPolicy(),
+			accountsRepo:  repo,
+			oAuthClient:   hydra,
+			federationKey: []byte("key"),
+			connectors:    registry,
+		}
+		svc.initValidator()
+		return svc
+	}
+	ctx := incomingContext(
+		XForwardedFor, "127.0.0.1",
+		LoginChallenge, "challenge",
+	)
+	claims := map[string]interface{}{
+		"sub":            "corp-123",
+		"email":          "isaiah@example.com",
+		"email_verified": true,
+		"name":           "Isaiah Wong",
+	}
+	identity := bson.M{
+		"identities": bson.M{"$elemMatch": bson.M{"issuer": idp.URL, "subject": "corp-123"}},
+	}
+	authorize := func(t *testing.T, svc *Service) *pb.CompleteFederatedLoginRequest {
+		resp, err := svc.StartFederatedLogin(ctx, &pb.StartFederatedLoginRequest{Connector: "corp"})
+		if !assert.NoError(t, err) {
+			t.FailNow()
+		}
+		code, state, err := idp.Authorize(resp.GetRedirectTo(), claims)
+		assert.NoError(t, err)
+		return &pb.CompleteFederatedLoginRequest{Code: code, State: state, Connector: "corp"}
+	}
+
+	t.Run("Creates account", func(t *testing.T) {
+		id := primitive.NewObjectID()
+		var saved *models.Account
+		repo := new(mocks.Repo)
+		repo.On("FindOne", nil, mock.Anything).Return(nil, nil)
+		repo.On("Update", nil, mock.Anything, mock.Anything).Return(1, nil)
+		repo.On("Save", nil, mock.Anything).Run(func(args mock.Arguments) {
+			saved = args.Get(1).(*models.Account)
+		}).Return(id.Hex(), nil)
+		svc := newService(repo)
+
+		resp, err := svc.CompleteFederatedLogin(ctx, authorize(t, svc))
+		assert.NoError(t, err)
+		assert.Equal(t, "https://example.com/callback", resp.GetRedirectTo())
+		assert.Equal(t, id.Hex(), accepted.Subject)
+		assert.Equal(t, "Isaiah Wong", saved.Auth.Name)
+		assert.True(t, saved.Auth.Verified)
+		if assert.Len(t, saved.Identities, 1) {
+			assert.Equal(t, idp.URL, saved.Identities[0].Issuer)
+			assert.Equal(t, "corp-123", saved.Identities[0].Subject)
+			assert.Equal(t, "corp", saved.Identities[0].Connector)
+		}
+	})
+
+	t.Run("Signs in linked account", func(t *testing.T) {
+		acc := &models.Account{
+			ID:         primitive.NewObjectID(),
+			Auth:       models.Auth{Email: "isaiah@example.com", Verified: true},
+			Identities: []models.Identity{{Issuer: idp.URL, Subject: "corp-123"}},
+		}
+		filter := bson.M{"_id": acc.ID}
+		for k, v := range identity {
+			filter[k] = v
+		}
+		repo := new(mocks.Repo)
+		repo.On("FindOne", nil, identity).Return(acc, nil)
+		repo.On("Update", nil, filter, mock.MatchedBy(func(u bson.M) bool {
+			_, ok := u["$set"].(bson.M)["identities.$.last_login"]
+			return ok
+		})).Return(1, nil)
+		repo.On("Update", nil, bson.M{"_id": acc.ID}, mock.Anything).Return(1, nil)
+		svc := newService(repo)
+
+		_, err := svc.CompleteFederatedLogin(ctx, authorize(t, svc))
+		assert.NoError(t, err)
+		assert.Equal(t, acc.ID.Hex(), accepted.Subject)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("Links account by verified email", func(t *testing.T) {
+		acc := &models.Account{
+			ID:   primitive.NewObjectID(),
+			Auth: models.Auth{Email: "isaiah@example.com", Verified: true},
+		}
+		var update bson.M
+		repo := new(mocks.Repo)
+		repo.On("FindOne", nil, identity).Return(nil, nil)
+		repo.On("FindOne", nil, mock.Anything).Return(acc, nil)
+		repo.On("Update", nil, bson.M{"_id": acc.ID}, mock.Anything).Run(func(args mock.Arguments) {
+			if update == nil {
+				update = args.Get(2).(bson.M)
+			}
+		}).Return(1, nil)
+		svc := newService(repo)
+
+		_, err := svc.CompleteFederatedLogin(ctx, authorize(t, svc))
+		assert.NoError(t, err)
+		linked := update["$set"].(bson.M)["identities"].([]models.Identity)
+		assert.Equal(t, "corp-123", linked[0].Subject)
+	})
+
+	t.Run("Unknown connector", func(t *testing.T) {
+		svc := newService(new(mocks.Repo))
+		_, err := svc.StartFederatedLogin(ctx, &pb.StartFederatedLoginRequest{Connector: "other"})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+
+	t.Run("State of another connector", func(t *testing.T) {
+		svc := newService(new(mocks.Repo))
+		_, state, _, err := svc.startFederatedRequest(ctx, "google", new(string))
+		assert.NoError(t, err)
+		_, err = svc.CompleteFederatedLogin(ctx, &pb.CompleteFederatedLoginRequest{Code: "code", State: state, Connector: "corp"})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+}
@@ -0,0 +1,333 @@
+package accounts
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	accountsV1 "github.com/isaiahwong/accounts-go/api/accounts/v1"
+	mailV1 "github.com/isaiahwong/accounts-go/api/mail/v1"
+	"github.com/isaiahwong/accounts-go/internal/common"
+	"github.com/isaiahwong/accounts-go/internal/common/validator"
+	"github.com/isaiahwong/accounts-go/internal/models"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// emailChangeExpiry defines how long a token verifying a new email is valid
+// for
+const emailChangeExpiry = 24 * time.Hour
+
+// emailRevertExpiry defines how long the previous address can undo an email
+// change for
+const emailRevertExpiry = 7 * 24 * time.Hour
+
+// checkEmailAvailable returns an AlreadyExists status error when an account
+// other than id uses the email
+func (s *Service) checkEmailAvailable(ctx context.Context, id primitive.ObjectID, email string, prefix string) error {
+	u, err := s.findAccountByEmail(nil, email)
+	if err != nil {
+		s.logger.Errorf("%v: %v", prefix, err)
+		return status.Error(codes.Internal, "An Internal error has occurred")
+	}
+	if u != nil && u.ID != id {
+		return s.returnErrors(ctx, []validator.Error{
+			{
+				Param:   "email",
+				Message: "Email is already in used",
+				Value:   email,
+			},
+		}, codes.AlreadyExists, "Email is already in used", prefix)
+	}
+	return nil
+}
+
+// checkRevertWindow returns a FailedPrecondition status error while the
+// address replaced by the last email change can still revert it. Otherwise
+// a second change would replace the revert token mailed to that address and
+// whoever changed the email could keep the account.
+func (s *Service) checkRevertWindow(u *models.Account) error {
+	if u.EmailChange.RevertToken != "" && time.Now().Before(u.EmailChange.RevertExpires) {
+		return status.Error(codes.FailedPrecondition, "Email was changed recently. Please try again later")
+	}
+	return nil
+}
+
+// RequestEmailChange is a gRPC handler that mails a verification token to a
+// new address for the authenticated account. The address replaces the
+// current one once confirmed through ConfirmEmailChange.
+func (s *Service) RequestEmailChange(ctx context.Context, req *accountsV1.EmailChangeRequest) (*accountsV1.Empty, error) {
+	api := "RequestEmailChange: "
+
+	email := strings.ToLower(strings.TrimSpace(req.GetEmail()))
+
+	errs := validator.Val(
+		s.validate,
+		validator.Field{
+			Param:   "email",
+			Message: "Invalid email",
+			Value:   email,
+			Tag:     "required,email,emailMX,max=64",
+		},
+	)
+	u, err := s.bearerRequest(ctx, &api, errs...)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkRevertWindow(u); err != nil {
+		return nil, err
+	}
+	if email == u.Auth.Email {
+		return nil, s.returnErrors(ctx, []validator.Error{
+			{
+				Param:   "email",
+				Message: "Email is the account's current email",
+				Value:   email,
+			},
+		}, codes.InvalidArgument, "Malformed request", api)
+	}
+	if err := s.checkEmailAvailable(ctx, u.ID, email, api); err != nil {
+		return nil, err
+	}
+
+	t, err := generateToken(32)
+	if err != nil {
+		s.logger.Errorf("%v: %v", api, err)
+		return nil, status.Error(codes.Internal, "An Internal error has occurred")
+	}
+
+	_, err = s.accountsRepo.Update(
+		nil,
+		bson.M{"_id": u.ID},
+		bson.M{
+			"$set": bson.M{
+				"updated_at":           time.Now(),
+				"email_change.pending": email,
+				"email_change.token":   hashToken(t),
+				"email_change.expires": time.Now().Add(emailChangeExpiry),
+			},
+		},
+	)
+	if err != nil {
+		s.logger.Errorf("%v: %v", api, err)
+		return nil, status.Error(codes.Internal, "An Internal error has occurred")
+	}
+
+	_, err = s.mailSVC.SendEmailChangeVerification(ctx, &mailV1.EmailChangeVerificationRequest{
+		Email: email,
+		Token: t,
+	})
+	if err != nil {
+		s.logger.Errorf("%v: mailSVC SendEmailChangeVerification: %v", api, err)
+		return nil, status.Error(codes.Internal, "An Internal error has occurred")
+	}
+
+	return &accountsV1.Empty{}, nil
+}
+
+// ConfirmEmailChange is a gRPC handler that replaces the account's email
+// with the pending address given the token mailed to it. The previous
+// address is mailed a token which reverts the change through
+// RevertEmailChange.
+func (s *Service) ConfirmEmailChange(ctx context.Context, req *accountsV1.ConfirmEmailChangeRequest) (*accountsV1.Empty, error) {
+	api := "ConfirmEmailChange: "
+
+	ip := common.GetMetadataValue(ctx, XForwardedFor)
+	token := strings.TrimSpace(req.GetToken())
+
+	errs := validator.Val(
+		s.validate,
+		validator.Field{
+			Param:          "token",
+			Message:        "Invalid verification token",
+			Value:          token,
+			Tag:            "required",
+			OmitParamValue: true,
+		},
+		validator.Field{
+			Param:   XForwardedFor,
+			Message: XForwardedFor + " header required",
+			Value:   ip,
+			Tag:     `required`,
+		},
+	)
+	// Validate
+	if len(errs) > 0 {
+		return nil, s.returnErrors(ctx, errs, codes.InvalidArgument, "Malformed request", api)
+	}
+	// Prepend IP for logging
+	api = fmt.Sprintf("[%v] %v", ip, api)
+
+	invalidToken := []validator.Error{
+		{
+			Param:   "token",
+			Message: "Verification token is invalid or has expired",
+		},
+	}
+
+	digest := hashToken(token)
+	u, err := s.accountsRepo.FindOne(nil, bson.M{"email_change.token": digest})
+	if err != nil {
+		s.logger.Errorf("%v: %v", api, err)
+		return nil, status.Error(codes.Internal, "An Internal error has occurred")
+	}
+	if u == nil || u.EmailChange.Pending == "" || time.Now().After(u.EmailChange.Expires) {
+		return nil, s.returnErrors(ctx, invalidToken, codes.InvalidArgument, "Invalid token", api)
+	}
+	// A change requested before another was confirmed must not replace the
+	// revert token of the first
+	if err := s.checkRevertWindow(u); err != nil {
+		return nil, err
+	}
+	// The address may have been taken since the change was requested
+	if err := s.checkEmailAvailable(ctx, u.ID, u.EmailChange.Pending, api); err != nil {
+		return nil, err
+	}
+
+	revert, err := generateToken(32)
+	if err != nil {
+		s.logger.Errorf("%v: %v", api, err)
+		return nil, status.Error(codes.Internal, "An Internal error has occurred")
+	}
+
+	// Tokens mailed to the previous address no longer apply
+	_, err = s.accountsRepo.Update(
+		nil,
+		bson.M{
+			"_id":                u.ID,
+			"email_change.token": digest,
+		},
+		bson.M{
+			"$set": bson.M{
+				"updated_at":                  time.Now(),
+				"auth.email":                  u.EmailChange.Pending,
+				"auth.verified":               true,
+				"auth.verified_date":          time.Now(),
+				"auth.verification_token":     "",
+				"auth.password_reset_token":   "",
+				"auth.password_reset_expires": time.Time{},
+				"magic_link":                  models.MagicLink{},
+				"login_code.code":             "",
+				"email_change": models.EmailChange{
+					RevertEmail:   u.Auth.Email,
+					RevertToken:   hashToken(revert),
+					RevertExpires: time.Now().Add(emailRevertExpiry),
+				},
+			},
+		},
+	)
+	if err == mongo.ErrNoDocuments {
+		return nil, s.returnErrors(ctx, invalidToken, codes.InvalidArgument, "Invalid token", api)
+	}
+	if err != nil {
+		s.logger.Errorf("%v: %v", api, err)
+		return nil, status.Error(codes.Internal, "An Internal error has occurred")
+	}
+
+	// The email has already been changed at this point so a failure to
+	// notify should not fail the request
+	_, err = s.mailSVC.SendEmailChangeNotification(ctx, &mailV1.EmailChangeNotificationRequest{
+		Email:       u.Auth.Email,
+		NewEmail:    u.EmailChange.Pending,
+		RevertToken: revert,
+	})
+	if err != nil {
+		s.logger.Errorf("%v: mailSVC SendEmailChangeNotification: %v", api, err)
+	}
+
+	return &accountsV1.Empty{}, nil
+}
+
+// RevertEmailChange is a gRPC handler that restores the address an email
+// change replaced given the token mailed to it, and signs the account out
+// everywhere so whoever changed it loses access.
+func (s *Service) RevertEmailChange(ctx context.Context, req *accountsV1.RevertEmailChangeRequest) (*accountsV1.Empty, error) {
+	api := "RevertEmailChange: "
+
+	ip := common.GetMetadataValue(ctx, XForwardedFor)
+	token := strings.TrimSpace(req.GetToken())
+
+	errs := validator.Val(
+		s.validate,
+		validator.Field{
+			Param:          "token",
+			Message:        "Invalid revert token",
+			Value:          token,
+			Tag:            "required",
+			OmitParamValue: true,
+		},
+		validator.Field{
+			Param:   XForwardedFor,
+			Message: XForwardedFor + " header required",
+			Value:   ip,
+			Tag:     `required`,
+		},
+	)
+	// Validate
+	if len(errs) > 0 {
+		return nil, s.returnErrors(ctx, errs, codes.InvalidArgument, "Malformed request", api)
+	}
+	// Prepend IP for logging
+	api = fmt.Sprintf("[%v] %v", ip, api)
+
+	invalidToken := []validator.Error{
+		{
+			Param:   "token",
+			Message: "Revert token is invalid or has expired",
+		},
+	}
+
+	digest := hashToken(token)
+	u, err := s.accountsRepo.FindOne(nil, bson.M{"email_change.revert_token": digest})
+	if err != nil {
+		s.logger.Errorf("%v: %v", api, err)
+		return nil, status.Error(codes.Internal, "An Internal error has occurred")
+	}
+	if u == nil || u.EmailChange.RevertEmail == "" || time.Now().After(u.EmailChange.RevertExpires) {
+		return nil, s.returnErrors(ctx, invalidToken, codes.InvalidArgument, "Invalid token", api)
+	}
+	if err := s.checkEmailAvailable(ctx, u.ID, u.EmailChange.RevertEmail, api); err != nil {
+		return nil, err
+	}
+
+	// Tokens mailed to the replacing address no longer apply
+	_, err = s.accountsRepo.Update(
+		nil,
+		bson.M{
+			"_id":                       u.ID,
+			"email_change.revert_token": digest,
+		},
+		bson.M{
+			"$set": bson.M{
+				"updated_at":                  time.Now(),
+				"auth.email":                  u.EmailChange.RevertEmail,
+				"auth.verified":               true,
+				"auth.verified_date":          time.Now(),
+				"auth.verification_token":     "",
+				"auth.password_reset_token":   "",
+				"auth.password_reset_expires": time.Time{},
+				"magic_link":                  models.MagicLink{},
+				"login_code.code":             "",
+				"email_change":                models.EmailChange{},
+			},
+		},
+	)
+	if err == mongo.ErrNoDocuments {
+		return nil, s.returnErrors(ctx, invalidToken, codes.InvalidArgument, "Invalid token", api)
+	}
+	if err != nil {
+		s.logger.Errorf("%v: %v", api, err)
+		return nil, status.Error(codes.Internal, "An Internal error has occurred")
+	}
+
+	if err := s.revokeOtherSessions(u.ID.Hex(), ""); err != nil {
+		s.logger.Errorf("%v: revoke sessions: %v", api, err)
+		return nil, status.Error(codes.Internal, "Email was restored but sessions could not be revoked")
+	}
+
+	return &accountsV1.Empty{}, nil
+}
@@ -0,0 +1,218 @@
+package accounts
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	pb "github.com/isaiahwong/accounts-go/api/accounts/v1"
+	"github.com/isaiahwong/accounts-go/internal/models"
+	"github.com/isaiahwong/accounts-go/internal/oauth"
+	"github.com/isaiahwong/accounts-go/tests/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestEmailChange(t *testing.T) {
+	acc := &models.Account{
+		ID: primitive.NewObjectID(),
+		Auth: models.Auth{
+			Email:              "isaiah@example.com",
+			Verified:           true,
+			PasswordResetToken: hashToken("reset"),
+		},
+	}
+	other := &models.Account{
+		ID:   primitive.NewObjectID(),
+		Auth: models.Auth{Email: "taken@example.com"},
+	}
+
+	var revoked []string
+	hydra, srv := setupHydra(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/oauth2/introspect":
+			json.NewEncoder(w).Encode(oauth.InstrospectResponse{Active: true, Sub: acc.ID.Hex(), ClientID: "web"})
+		case r.URL.Path == "/oauth2/auth/sessions/login" && r.Method == "DELETE":
+			revoked = append(revoked, "login")
+			w.WriteHeader(http.StatusNoContent)
+		case r.URL.Path == "/oauth2/auth/sessions/consent" && r.Method == "GET":
+			sessions := make([]oauth.HydraConsentSession, 1)
+			sessions[0].ConsentRequest.Client.ClientID = "web"
+			json.NewEncoder(w).Encode(sessions)
+		case r.URL.Path == "/oauth2/auth/sessions/consent" && r.Method == "DELETE":
+			revoked = append(revoked, "consent:"+r.URL.Query().Get("client"))
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+	defer srv.Close()
+
+	// Serve acc and other from memory and apply updates to acc
+	repo := new(mocks.Repo)
+	repo.On("GetTimeout").Return(time.Second)
+	repo.On("FindOne", mock.Anything, mock.Anything).Return(func(_ context.Context, f interface{}, _ ...interface{}) *models.Account {
+		filter := f.(bson.M)
+		if or, ok := filter["$or"]; ok {
+			email := or.([]interface{})[0].(bson.M)["auth.email"]
+			for _, u := range []*models.Account{acc, other} {
+				if u.Auth.Email == email {
+					return u
+				}
+			}
+			return nil
+		}
+		if t, ok := filter["email_change.token"]; ok && t != acc.EmailChange.Token {
+			return nil
+		}
+		if t, ok := filter["email_change.revert_token"]; ok && t != acc.EmailChange.RevertToken {
+			return nil
+		}
+		return acc
+	}, nil)
+	repo.On("Update", nil, mock.Anything, mock.Anything).Return(1, func(_ context.Context, filter interface{}, update interface{}) error {
+		f := filter.(bson.M)
+		if t, ok := f["email_change.token"]; ok && t != acc.EmailChange.Token {
+			return mongo.ErrNoDocuments
+		}
+		if t, ok := f["email_change.revert_token"]; ok && t != acc.EmailChange.RevertToken {
+			return mongo.ErrNoDocuments
+		}
+		set := update.(bson.M)["$set"].(bson.M)
+		if v, ok := set["email_change.pending"]; ok {
+			acc.EmailChange.Pending = v.(string)
+			acc.EmailChange.Token = set["email_change.token"].(string)
+			acc.EmailChange.Expires = set["email_change.expires"].(time.Time)
+		}
+		if v, ok := set["email_change"]; ok {
+			acc.EmailChange = v.(models.EmailChange)
+		}
+		if v, ok := set["auth.email"]; ok {
+			acc.Auth.Email = v.(string)
+			acc.Auth.PasswordResetToken = set["auth.password_reset_token"].(string)
+		}
+		return nil
+	})
+
+	mail := &mailStub{}
+	svc := &Service{
+		logger:       logger,
+		accountsRepo: repo,
+		oAuthClient:  hydra,
+		mailSVC:      mail,
+	}
+	svc.initValidator()
+	ctx := incomingContext(
+		XForwardedFor, "127.0.0.1",
+		Authorization, "Bearer token",
+	)
+	request := func(email string) error {
+		_, err := svc.RequestEmailChange(ctx, &pb.EmailChangeRequest{Email: email})
+		return err
+	}
+	confirm := func(token string) error {
+		_, err := svc.ConfirmEmailChange(ctx, &pb.ConfirmEmailChangeRequest{Token: token})
+		return err
+	}
+	revert := func(token string) error {
+		_, err := svc.RevertEmailChange(ctx, &pb.RevertEmailChangeRequest{Token: token})
+		return err
+	}
+
+	t.Run("Malformed", func(t *testing.T) {
+		assert.Equal(t, codes.InvalidArgument, status.Code(request("not an email")))
+		assert.Equal(t, codes.InvalidArgument, status.Code(request("isaiah@example.com")))
+		_, err := svc.RequestEmailChange(incomingContext(XForwardedFor, "127.0.0.1"), &pb.EmailChangeRequest{Email: "new@example.com"})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		assert.Equal(t, codes.InvalidArgument, status.Code(confirm("")))
+		assert.Equal(t, codes.InvalidArgument, status.Code(revert("")))
+	})
+
+	t.Run("Email taken", func(t *testing.T) {
+		assert.Equal(t, codes.AlreadyExists, status.Code(request("Taken@example.com")))
+		assert.Empty(t, mail.sent)
+	})
+
+	t.Run("Request and confirm", func(t *testing.T) {
+		assert.NoError(t, request(" New@Example.com "))
+		assert.Equal(t, []string{"SendEmailChangeVerification"}, mail.sent)
+		assert.Equal(t, "new@example.com", acc.EmailChange.Pending)
+		assert.Equal(t, "isaiah@example.com", acc.Auth.Email)
+		token := mail.tokens[0]
+		assert.Equal(t, hashToken(token), acc.EmailChange.Token)
+
+		assert.Equal(t, codes.InvalidArgument, status.Code(confirm("wrong")))
+		assert.NoError(t, confirm(token))
+		assert.Equal(t, "new@example.com", acc.Auth.Email)
+		assert.Empty(t, acc.Auth.PasswordResetToken)
+		assert.Equal(t, "isaiah@example.com", acc.EmailChange.RevertEmail)
+		assert.Equal(t, []string{"SendEmailChangeVerification", "SendEmailChangeNotification"}, mail.sent)
+
+		// Tokens are single use
+		assert.Equal(t, codes.InvalidArgument, status.Code(confirm(token)))
+	})
+
+	t.Run("Revert", func(t *testing.T) {
+		token := mail.tokens[1]
+		assert.Equal(t, hashToken(token), acc.EmailChange.RevertToken)
+
+		assert.Equal(t, codes.InvalidArgument, status.Code(revert("wrong")))
+		assert.NoError(t, revert(token))
+		assert.Equal(t, "isaiah@example.com", acc.Auth.Email)
+		assert.Equal(t, models.EmailChange{}, acc.EmailChange)
+		// Every client is signed out, including the one which made the change
+		assert.Equal(t, []string{"login", "consent:web"}, revoked)
+
+		assert.Equal(t, codes.InvalidArgument, status.Code(revert(token)))
+	})
+
+	t.Run("Expired", func(t *testing.T) {
+		assert.NoError(t, request("new@example.com"))
+		acc.EmailChange.Expires = time.Now().Add(-time.Minute)
+		assert.Equal(t, codes.InvalidArgument, status.Code(confirm(mail.tokens[2])))
+		assert.Equal(t, "isaiah@example.com", acc.Auth.Email)
+	})
+
+	t.Run("Taken before confirmation", func(t *testing.T) {
+		assert.NoError(t, request("late@example.com"))
+		other.Auth.Email = "late@example.com"
+		assert.Equal(t, codes.AlreadyExists, status.Code(confirm(mail.tokens[3])))
+		assert.Equal(t, "isaiah@example.com", acc.Auth.Email)
+	})
+
+	t.Run("Chained change", func(t *testing.T) {
+		assert.NoError(t, request("second@example.com"))
+		assert.NoError(t, confirm(mail.tokens[4]))
+		revertToken := mail.tokens[5]
+
+		// A stolen token cannot move the email again to drop the revert
+		assert.Equal(t, codes.FailedPrecondition, status.Code(request("third@example.com")))
+		assert.Equal(t, "isaiah@example.com", acc.EmailChange.RevertEmail)
+		assert.Equal(t, hashToken(revertToken), acc.EmailChange.RevertToken)
+
+		// Nor can a change requested before the first was confirmed
+		acc.EmailChange.Pending = "third@example.com"
+		acc.EmailChange.Token = hashToken("pending")
+		acc.EmailChange.Expires = time.Now().Add(time.Hour)
+		assert.Equal(t, codes.FailedPrecondition, status.Code(confirm("pending")))
+		assert.Equal(t, "second@example.com", acc.Auth.Email)
+
+		assert.NoError(t, revert(revertToken))
+		assert.Equal(t, "isaiah@example.com", acc.Auth.Email)
+
+		// Changes are allowed again once the window closes
+		acc.EmailChange = models.EmailChange{
+			RevertEmail:   "old@example.com",
+			RevertToken:   hashToken("old"),
+			RevertExpires: time.Now().Add(-time.Minute),
+		}
+		assert.NoError(t, request("third@example.com"))
+	})
+}
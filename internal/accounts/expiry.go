@@ -0,0 +1,137 @@
+package accounts
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	accountsV1 "github.com/isaiahwong/accounts-go/api/accounts/v1"
+	mailV1 "github.com/isaiahwong/accounts-go/api/mail/v1"
+	"github.com/isaiahwong/accounts-go/internal/common"
+	"github.com/isaiahwong/accounts-go/internal/common/validator"
+	"github.com/isaiahwong/accounts-go/internal/models"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// passwordExpired reports whether the account's password is older than the
+// maximum age. Accounts which never recorded a change are aged from their
+// creation.
+func (s *Service) passwordExpired(u *models.Account) bool {
+	if s.passwordMaxAge <= 0 || u.Auth.Password == "" {
+		return false
+	}
+	modified := u.Auth.PasswordModified
+	if modified.IsZero() {
+		modified = u.CreatedAt
+	}
+	return !modified.IsZero() && time.Since(modified) > s.passwordMaxAge
+}
+
+// ChangeExpiredPassword is a gRPC handler that replaces an expired password
+// for a login Authenticate answered with PasswordChangeRequired. The login
+// challenge is then accepted, deferring to the second factor when enabled.
+func (s *Service) ChangeExpiredPassword(ctx context.Context, req *accountsV1.ChangeExpiredPasswordRequest) (*accountsV1.RedirectResponse, error) {
+	api := "ChangeExpiredPassword: "
+
+	ip := common.GetMetadataValue(ctx, XForwardedFor)
+	challenge := common.GetMetadataValue(ctx, LoginChallenge)
+	password := s.passwordPolicy.Normalize(strings.TrimSpace(req.GetPassword()))
+	cpassword := s.passwordPolicy.Normalize(strings.TrimSpace(req.GetConfirmPassword()))
+
+	errs := validator.Val(
+		s.validate,
+		validator.Field{
+			Param:          "confirm_password",
+			Message:        "Passwords do not match",
+			Value:          cpassword,
+			OtherValue:     password,
+			Tag:            `eqfield`,
+			OmitParamValue: true,
+		},
+		validator.Field{
+			Param:   LoginChallenge,
+			Message: LoginChallenge + " header required",
+			Value:   challenge,
+			Tag:     `required`,
+		},
+		validator.Field{
+			Param:   XForwardedFor,
+			Message: XForwardedFor + " header required",
+			Value:   ip,
+			Tag:     `required`,
+		},
+	)
+	errs = append(errs, s.checkPassword(password)...)
+	// Validate
+	if len(errs) > 0 {
+		return nil, s.returnErrors(ctx, errs, codes.InvalidArgument, "Malformed request", api)
+	}
+	// Prepend IP for logging
+	api = fmt.Sprintf("[%v] %v", ip, api)
+
+	u, err := s.findPasswordChangeLogin(challenge)
+	if err != nil {
+		s.logger.Errorf("%v: %v", api, err)
+		return nil, status.Error(codes.Internal, "An Internal error has occurred")
+	}
+	if u == nil {
+		return nil, status.Error(codes.PermissionDenied, "Login session has expired. Please sign in again")
+	}
+	if errs := s.checkPassword(password, u.Auth.Email, u.Auth.FirstName, u.Auth.LastName); len(errs) > 0 {
+		return nil, s.returnErrors(ctx, errs, codes.InvalidArgument, "Malformed request", api)
+	}
+	// Reject passwords known from breaches
+	if err := s.screenPassword(ctx, password, api); err != nil {
+		return nil, err
+	}
+	if err := s.checkPasswordReuse(ctx, u, password, api); err != nil {
+		return nil, err
+	}
+
+	// Hash password
+	hash, method, err := s.hashers.Hash(password)
+	if err != nil {
+		s.logger.Errorf("%v: %v", api, err)
+		return nil, status.Error(codes.Internal, "An Internal error has occurred")
+	}
+
+	// Match on the pending login so it can only be used once
+	amr := u.PendingLogin.AMR
+	_, err = s.accountsRepo.Update(
+		nil,
+		bson.M{
+			"_id":                     u.ID,
+			"pending_login.challenge": u.PendingLogin.Challenge,
+		},
+		bson.M{
+			"$set": bson.M{
+				"updated_at":                time.Now(),
+				"auth.password":             hash,
+				"auth.password_hash_method": method,
+				"auth.password_modified":    time.Now(),
+				"auth.password_history":     s.nextPasswordHistory(u),
+				"pending_login":             models.PendingLogin{},
+			},
+		},
+	)
+	if err == mongo.ErrNoDocuments {
+		return nil, status.Error(codes.PermissionDenied, "Login session has expired. Please sign in again")
+	}
+	if err != nil {
+		s.logger.Errorf("%v: %v", api, err)
+		return nil, status.Error(codes.Internal, "An Internal error has occurred")
+	}
+
+	// The password has already been changed at this point so a failure to
+	// notify should not fail the request
+	_, err = s.mailSVC.SendResetPasswordConfirmation(ctx, &mailV1.EmailRequest{Email: u.Auth.Email})
+	if err != nil {
+		s.logger.Errorf("%v: mailSVC SendResetPasswordConfirmation: %v", api, err)
+	}
+
+	return s.completeLogin(ctx, challenge, u, api, amr...)
+}
@@ -0,0 +1,136 @@
+package accounts
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	pb "github.com/isaiahwong/accounts-go/api/accounts/v1"
+	"github.com/isaiahwong/accounts-go/internal/common/password"
+	"github.com/isaiahwong/accounts-go/internal/models"
+	"github.com/isaiahwong/accounts-go/internal/oauth"
+	"github.com/isaiahwong/accounts-go/tests/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestPasswordExpired(t *testing.T) {
+	svc := &Service{passwordMaxAge: 24 * time.Hour}
+	acc := func(modified, created time.Time) *models.Account {
+		return &models.Account{
+			Auth:      models.Auth{Password: "hash", PasswordModified: modified},
+			CreatedAt: created,
+		}
+	}
+	old := time.Now().Add(-48 * time.Hour)
+
+	assert.False(t, svc.passwordExpired(acc(time.Now(), old)))
+	assert.True(t, svc.passwordExpired(acc(old, old)))
+	assert.True(t, svc.passwordExpired(acc(time.Time{}, old)))
+	assert.False(t, svc.passwordExpired(acc(time.Time{}, time.Time{})))
+	assert.False(t, (&Service{}).passwordExpired(acc(old, old)))
+}
+
+func TestChangeExpiredPassword(t *testing.T) {
+	hash, method, _ := testHashers.Hash(validPasswords[0])
+	acc := &models.Account{
+		ID: primitive.NewObjectID(),
+		Auth: models.Auth{
+			Email:              "isaiah@example.com",
+			Password:           hash,
+			PasswordHashMethod: method,
+			PasswordModified:   time.Now().Add(-100 * 24 * time.Hour),
+			Verified:           true,
+		},
+	}
+
+	var accepted *oauth.HydraLoginAccept
+	hydra, srv := setupHydra(func(w http.ResponseWriter, r *http.Request) {
+		accepted = &oauth.HydraLoginAccept{}
+		json.NewDecoder(r.Body).Decode(accepted)
+		json.NewEncoder(w).Encode(oauth.HydraRedirect{RedirectTo: "https://example.com/callback"})
+	})
+	defer srv.Close()
+
+	// Apply pending login and password updates to acc
+	repo := new(mocks.Repo)
+	repo.On("FindOne", nil, mock.MatchedBy(func(f bson.M) bool {
+		change, ok := f["pending_login.password_change"]
+		return ok && change == true && f["pending_login.challenge"] == acc.PendingLogin.Challenge && acc.PendingLogin.PasswordChange
+	})).Return(acc, nil)
+	repo.On("FindOne", nil, mock.MatchedBy(func(f bson.M) bool {
+		_, ok := f["$or"]
+		return ok
+	})).Return(acc, nil)
+	repo.On("FindOne", nil, mock.Anything).Return(nil, nil)
+	repo.On("Update", nil, mock.Anything, mock.Anything).Return(1, func(_ context.Context, _ interface{}, update interface{}) error {
+		set := update.(bson.M)["$set"].(bson.M)
+		if v, ok := set["pending_login"]; ok {
+			acc.PendingLogin = v.(models.PendingLogin)
+		}
+		if v, ok := set["auth.password"]; ok {
+			acc.Auth.Password = v.(string)
+			acc.Auth.PasswordHashMethod = set["auth.password_hash_method"].(string)
+			acc.Auth.PasswordModified = set["auth.password_modified"].(time.Time)
+		}
+		return nil
+	})
+
+	mail := &mailStub{}
+	svc := &Service{
+		logger:          logger,
+		accountsRepo:    repo,
+		hashers:         testHashers,
+		passwordPolicy:  password.DefaultPolicy(),
+		passwordHistory: 3,
+		passwordMaxAge:  90 * 24 * time.Hour,
+		oAuthClient:     hydra,
+		mailSVC:         mail,
+	}
+	svc.initValidator()
+	ctx := incomingContext(
+		XForwardedFor, "127.0.0.1",
+		CaptchaResponse, "captcha",
+		LoginChallenge, "challenge",
+	)
+	change := func(pw string) (*pb.RedirectResponse, error) {
+		return svc.ChangeExpiredPassword(ctx, &pb.ChangeExpiredPasswordRequest{Password: pw, ConfirmPassword: pw})
+	}
+
+	// Without an expired login
+	_, err := change(validPasswords[1])
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	resp, err := svc.Authenticate(ctx, &pb.AuthenticateRequest{Email: acc.Auth.Email, Password: validPasswords[0]})
+	assert.NoError(t, err)
+	assert.True(t, resp.PasswordChangeRequired)
+	assert.Empty(t, resp.RedirectTo)
+	assert.Nil(t, accepted)
+
+	// The pending login cannot be completed with a second factor
+	u, err := svc.findPendingLogin("challenge")
+	assert.NoError(t, err)
+	assert.Nil(t, u)
+
+	// The current password cannot be reused
+	_, err = change(validPasswords[0])
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	resp, err = change(validPasswords[1])
+	assert.NoError(t, err)
+	assert.Equal(t, "https://example.com/callback", resp.RedirectTo)
+	assert.Equal(t, []string{oauth.AMRPassword}, accepted.Amr)
+	assert.False(t, svc.passwordExpired(acc))
+	assert.Equal(t, models.PendingLogin{}, acc.PendingLogin)
+	assert.Equal(t, []string{"SendResetPasswordConfirmation"}, mail.sent)
+
+	// The pending login was consumed
+	_, err = change(validPasswords[1])
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
@@ -0,0 +1,76 @@
+package accounts
+
+import (
+	"context"
+
+	accountsV1 "github.com/isaiahwong/accounts-go/api/accounts/v1"
+	"github.com/isaiahwong/accounts-go/internal/models"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// StartFacebookLogin is a gRPC handler that returns the Facebook login
+// dialog URL for a login challenge
+func (s *Service) StartFacebookLogin(ctx context.Context, _ *accountsV1.Empty) (*accountsV1.FederatedLoginResponse, error) {
+	api := "StartFacebookLogin: "
+
+	if s.facebook == nil {
+		return nil, status.Error(codes.Unimplemented, "Facebook login is not configured")
+	}
+	_, state, _, err := s.startFederatedRequest(ctx, "facebook", &api)
+	if err != nil {
+		return nil, err
+	}
+
+	return &accountsV1.FederatedLoginResponse{
+		RedirectTo: s.facebook.AuthCodeURL(state),
+		State:      state,
+	}, nil
+}
+
+// CompleteFacebookLogin is a gRPC handler that exchanges the authorization
+// code returned by Facebook, signs in or creates the linked account and
+// accepts the login challenge
+func (s *Service) CompleteFacebookLogin(ctx context.Context, req *accountsV1.CompleteFederatedLoginRequest) (*accountsV1.RedirectResponse, error) {
+	api := "CompleteFacebookLogin: "
+
+	if s.facebook == nil {
+		return nil, status.Error(codes.Unimplemented, "Facebook login is not configured")
+	}
+	ip, challenge, code, _, err := s.completeFederatedRequest(ctx, req, "facebook", &api)
+	if err != nil {
+		return nil, err
+	}
+
+	fb, err := s.facebook.Login(ctx, code)
+	if err != nil {
+		s.logger.Errorf("%v: %v", api, err)
+		return nil, status.Error(codes.PermissionDenied, "Facebook login failed")
+	}
+
+	profile := &models.UserProfile{
+		Provider:    "facebook",
+		ID:          fb.ID,
+		DisplayName: fb.Name,
+		Name: models.ProfileName{
+			GivenName:  fb.FirstName,
+			MiddleName: fb.MiddleName,
+			FamilyName: fb.LastName,
+		},
+	}
+	if fb.Email != "" {
+		profile.Emails = []models.ProfileEmail{{Value: fb.Email, Type: "account"}}
+	}
+	if fb.Picture.Data.URL != "" && !fb.Picture.Data.IsSilhouette {
+		profile.Photos = []models.ProfilePhoto{{Value: fb.Picture.Data.URL}}
+	}
+
+	// Facebook does not state whether the address has been verified so the
+	// account is only linked through its Facebook id
+	u, err := s.linkFederatedAccount(ctx, profileLink(profile, fb.Email, false), ip, api)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.completeLogin(ctx, challenge, u, api)
+}
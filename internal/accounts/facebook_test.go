@@ -0,0 +1,116 @@
+package accounts
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	pb "github.com/isaiahwong/accounts-go/api/accounts/v1"
+	"github.com/isaiahwong/accounts-go/internal/facebook"
+	"github.com/isaiahwong/accounts-go/internal/models"
+	"github.com/isaiahwong/accounts-go/internal/oauth"
+	"github.com/isaiahwong/accounts-go/tests/mocks"
+	"github.com/microcosm-cc/bluemonday"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestFacebookLogin(t *testing.T) {
+	// Fake Graph API
+	mux := http.NewServeMux()
+	mux.HandleFunc("/oauth/access_token", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]string{"access_token": "token"})
+	})
+	mux.HandleFunc("/me", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"id":"fb-123","name":"Isaiah Wong","first_name":"Isaiah","last_name":"Wong","email":"isaiah@example.com"}`))
+	})
+	graph := httptest.NewServer(mux)
+	defer graph.Close()
+
+	var accepted *oauth.HydraLoginAccept
+	hydra, srv := setupHydra(func(w http.ResponseWriter, r *http.Request) {
+		accepted = &oauth.HydraLoginAccept{}
+		json.NewDecoder(r.Body).Decode(accepted)
+		json.NewEncoder(w).Encode(oauth.HydraRedirect{RedirectTo: "https://example.com/callback"})
+	})
+	defer srv.Close()
+
+	newService := func(repo *mocks.Repo) (*Service, *mailStub) {
+		mail := &mailStub{}
+		svc := &Service{
+			logger:        logger,
+			policy:        bluemonday.StrictPolicy(),
+			accountsRepo:  repo,
+			oAuthClient:   hydra,
+			mailSVC:       mail,
+			federationKey: []byte("key"),
+			facebook: facebook.New(facebook.Config{
+				ClientID:     "client",
+				ClientSecret: "secret",
+				RedirectURL:  "https://example.com/facebook/callback",
+				GraphURL:     graph.URL,
+			}),
+		}
+		svc.initValidator()
+		return svc, mail
+	}
+	ctx := incomingContext(
+		XForwardedFor, "127.0.0.1",
+		LoginChallenge, "challenge",
+	)
+	start := func(t *testing.T, svc *Service) string {
+		resp, err := svc.StartFacebookLogin(ctx, &pb.Empty{})
+		if !assert.NoError(t, err) {
+			t.FailNow()
+		}
+		u, _ := url.Parse(resp.GetRedirectTo())
+		assert.Equal(t, resp.GetState(), u.Query().Get("state"))
+		return resp.GetState()
+	}
+
+	t.Run("Linked account", func(t *testing.T) {
+		acc := &models.Account{ID: primitive.NewObjectID()}
+		repo := new(mocks.Repo)
+		repo.On("FindOne", nil, bson.M{"auth.facebook.id": "fb-123"}).Return(acc, nil)
+		repo.On("Update", nil, mock.Anything, mock.Anything).Return(1, nil)
+		svc, _ := newService(repo)
+
+		resp, err := svc.CompleteFacebookLogin(ctx, &pb.CompleteFederatedLoginRequest{Code: "code", State: start(t, svc)})
+		assert.NoError(t, err)
+		assert.Equal(t, "https://example.com/callback", resp.GetRedirectTo())
+		assert.Equal(t, acc.ID.Hex(), accepted.Subject)
+	})
+
+	t.Run("Creates unverified account", func(t *testing.T) {
+		var saved *models.Account
+		repo := new(mocks.Repo)
+		repo.On("FindOne", nil, mock.Anything).Return(nil, nil)
+		repo.On("Update", nil, mock.Anything, mock.Anything).Return(1, nil)
+		repo.On("Save", nil, mock.Anything).Run(func(args mock.Arguments) {
+			saved = args.Get(1).(*models.Account)
+		}).Return(primitive.NewObjectID().Hex(), nil)
+		svc, mail := newService(repo)
+
+		_, err := svc.CompleteFacebookLogin(ctx, &pb.CompleteFederatedLoginRequest{Code: "code", State: start(t, svc)})
+		assert.NoError(t, err)
+		assert.Equal(t, "fb-123", saved.Auth.Facebook.ID)
+		assert.False(t, saved.Auth.Verified)
+		assert.Contains(t, mail.sent, "SendAccountVerification")
+	})
+
+	t.Run("Does not link by email", func(t *testing.T) {
+		repo := new(mocks.Repo)
+		repo.On("FindOne", nil, bson.M{"auth.facebook.id": "fb-123"}).Return(nil, nil)
+		repo.On("FindOne", nil, mock.Anything).Return(&models.Account{ID: primitive.NewObjectID()}, nil)
+		svc, _ := newService(repo)
+
+		_, err := svc.CompleteFacebookLogin(ctx, &pb.CompleteFederatedLoginRequest{Code: "code", State: start(t, svc)})
+		assert.Equal(t, codes.AlreadyExists, status.Code(err))
+	})
+}
@@ -0,0 +1,324 @@
+package accounts
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"strings"
+	"time"
+
+	accountsV1 "github.com/isaiahwong/accounts-go/api/accounts/v1"
+	mailV1 "github.com/isaiahwong/accounts-go/api/mail/v1"
+	"github.com/isaiahwong/accounts-go/internal/common"
+	"github.com/isaiahwong/accounts-go/internal/common/validator"
+	"github.com/isaiahwong/accounts-go/internal/models"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// startFederatedRequest validates the headers of a request starting an
+// upstream login with provider and returns the login challenge with a new
+// state and nonce. api is prefixed with the client's IP for logging.
+func (s *Service) startFederatedRequest(ctx context.Context, provider string, api *string) (challenge, state, nonce string, err error) {
+	ip := common.GetMetadataValue(ctx, XForwardedFor)
+	challenge = common.GetMetadataValue(ctx, LoginChallenge)
+
+	errs := validator.Val(
+		s.validate,
+		validator.Field{
+			Param:   XForwardedFor,
+			Message: XForwardedFor + " header required",
+			Value:   ip,
+			Tag:     `required`,
+		},
+		validator.Field{
+			Param:   LoginChallenge,
+			Message: LoginChallenge + " header required",
+			Value:   challenge,
+			Tag:     `required`,
+		},
+	)
+	// Validate
+	if len(errs) > 0 {
+		return "", "", "", s.returnErrors(ctx, errs, codes.InvalidArgument, "Malformed request", *api)
+	}
+	// Prepend IP for logging
+	*api = fmt.Sprintf("[%v] %v", ip, *api)
+
+	state, nonce, err = s.newFederationState(challenge, provider)
+	if err != nil {
+		s.logger.Errorf("%v: %v", *api, err)
+		return "", "", "", status.Error(codes.Internal, "An Internal error has occurred")
+	}
+	return challenge, state, nonce, nil
+}
+
+// completeFederatedRequest validates a CompleteFederatedLoginRequest and
+// its state issued for provider. The client's IP, the login challenge, the
+// authorization code and the expected nonce are returned. api is prefixed
+// with the client's IP for logging.
+func (s *Service) completeFederatedRequest(ctx context.Context, req *accountsV1.CompleteFederatedLoginRequest, provider string, api *string) (ip, challenge, code, nonce string, err error) {
+	ip = common.GetMetadataValue(ctx, XForwardedFor)
+	challenge = common.GetMetadataValue(ctx, LoginChallenge)
+	code = strings.TrimSpace(req.GetCode())
+	state := strings.TrimSpace(req.GetState())
+
+	errs := validator.Val(
+		s.validate,
+		validator.Field{
+			Param:          "code",
+			Message:        "Invalid code",
+			Value:          code,
+			Tag:            `required`,
+			OmitParamValue: true,
+		},
+		validator.Field{
+			Param:          "state",
+			Message:        "Invalid state",
+			Value:          state,
+			Tag:            `required`,
+			OmitParamValue: true,
+		},
+		validator.Field{
+			Param:   XForwardedFor,
+			Message: XForwardedFor + " header required",
+			Value:   ip,
+			Tag:     `required`,
+		},
+		validator.Field{
+			Param:   LoginChallenge,
+			Message: LoginChallenge + " header required",
+			Value:   challenge,
+			Tag:     `required`,
+		},
+	)
+	// Validate
+	if len(errs) > 0 {
+		return "", "", "", "", s.returnErrors(ctx, errs, codes.InvalidArgument, "Malformed request", *api)
+	}
+	// Prepend IP for logging
+	*api = fmt.Sprintf("[%v] %v", ip, *api)
+
+	nonce, ok := s.verifyFederationState(challenge, provider, state)
+	if !ok {
+		return "", "", "", "", s.returnErrors(ctx, []validator.Error{
+			{
+				Param:   "state",
+				Message: "Invalid state",
+			},
+		}, codes.PermissionDenied, "Invalid state", *api)
+	}
+	return ip, challenge, code, nonce, nil
+}
+
+// newFederationState returns the state parameter binding an upstream
+// authorization request to the login challenge and provider, along with the
+// nonce expected in the upstream ID token. Nothing is stored; both are
+// derived from federationKey.
+func (s *Service) newFederationState(challenge, provider string) (string, string, error) {
+	r, err := generateToken(16)
+	if err != nil {
+		return "", "", err
+	}
+	state := r + "." + s.federationMAC(challenge, provider, r)
+	return state, s.federationMAC("nonce", state), nil
+}
+
+// verifyFederationState reports whether state was issued for challenge and
+// provider and returns the nonce expected in the upstream ID token
+func (s *Service) verifyFederationState(challenge, provider, state string) (string, bool) {
+	parts := strings.SplitN(state, ".", 2)
+	if len(parts) != 2 {
+		return "", false
+	}
+	if !hmac.Equal([]byte(parts[1]), []byte(s.federationMAC(challenge, provider, parts[0]))) {
+		return "", false
+	}
+	return s.federationMAC("nonce", state), true
+}
+
+func (s *Service) federationMAC(values ...string) string {
+	m := hmac.New(sha256.New, s.federationKey)
+	m.Write([]byte(strings.Join(values, "|")))
+	return base64.RawURLEncoding.EncodeToString(m.Sum(nil))
+}
+
+// federatedLink describes an upstream account and how it is stored on a
+// local account
+type federatedLink struct {
+	// filter matches the account already linked
+	filter bson.M
+	// refresh is the update applied to the linked account on each login.
+	// It is filtered by filter as well.
+	refresh bson.M
+	// link returns the update linking an existing account
+	link func(u *models.Account) bson.M
+	// attach links a new account
+	attach func(u *models.Account)
+
+	email     string
+	verified  bool
+	firstname string
+	lastname  string
+	picture   string
+}
+
+// profileLink returns the link for a provider profile stored under
+// auth.<provider>
+func profileLink(profile *models.UserProfile, email string, verified bool) *federatedLink {
+	field := "auth." + profile.Provider
+	profile.Linked = time.Now()
+
+	l := &federatedLink{
+		filter:  bson.M{field + ".id": profile.ID},
+		refresh: bson.M{"$set": bson.M{"updated_at": time.Now(), field: profile}},
+		link: func(*models.Account) bson.M {
+			return bson.M{"$set": bson.M{"updated_at": time.Now(), field: profile}}
+		},
+		attach: func(u *models.Account) {
+			switch profile.Provider {
+			case "google":
+				u.Auth.Google = profile
+			case "facebook":
+				u.Auth.Facebook = profile
+			}
+		},
+		email:     email,
+		verified:  verified,
+		firstname: profile.Name.GivenName,
+		lastname:  profile.Name.FamilyName,
+	}
+	if len(profile.Photos) > 0 {
+		l.picture = profile.Photos[0].Value
+	}
+	return l
+}
+
+// linkFederatedAccount returns the account linked to the upstream account.
+// Accounts are matched on the link's filter first, then linked by email when
+// the upstream has verified it, otherwise created. Accounts created with an
+// unverified email are sent a verification email. Errors returned are gRPC
+// status errors.
+func (s *Service) linkFederatedAccount(ctx context.Context, l *federatedLink, ip string, prefix string) (*models.Account, error) {
+	u, err := s.accountsRepo.FindOne(nil, l.filter)
+	if err != nil {
+		s.logger.Errorf("%v: %v", prefix, err)
+		return nil, status.Error(codes.Internal, "An Internal error has occurred")
+	}
+	if u != nil {
+		// Keep the link's filter so refresh may use positional updates
+		filter := bson.M{"_id": u.ID}
+		for k, v := range l.filter {
+			filter[k] = v
+		}
+		_, err = s.accountsRepo.Update(nil, filter, l.refresh)
+		if err != nil {
+			s.logger.Errorf("%v: %v", prefix, err)
+			return nil, status.Error(codes.Internal, "An Internal error has occurred")
+		}
+		return u, nil
+	}
+
+	email, verified := l.email, l.verified
+	email = strings.ToLower(strings.TrimSpace(email))
+	if email == "" {
+		return nil, status.Error(codes.FailedPrecondition, "An email address is required to sign in")
+	}
+
+	u, err = s.findAccountByEmail(nil, email)
+	if err != nil {
+		s.logger.Errorf("%v: %v", prefix, err)
+		return nil, status.Error(codes.Internal, "An Internal error has occurred")
+	}
+
+	if u != nil {
+		// Only link when the provider vouches for the address
+		if !verified {
+			return nil, status.Error(codes.AlreadyExists, "Email is already in used")
+		}
+		update := l.link(u)
+		set, ok := update["$set"].(bson.M)
+		if !ok {
+			set = bson.M{}
+			update["$set"] = set
+		}
+		set["updated_at"] = time.Now()
+		if !u.Auth.Verified {
+			// Whoever registered the unverified account cannot be trusted
+			// with its password
+			set["auth.verified"] = true
+			set["auth.verified_date"] = time.Now()
+			set["auth.password"] = ""
+			set["auth.password_modified"] = time.Now()
+		}
+		_, err = s.accountsRepo.Update(nil, bson.M{"_id": u.ID}, update)
+		if err != nil {
+			s.logger.Errorf("%v: %v", prefix, err)
+			return nil, status.Error(codes.Internal, "An Internal error has occurred")
+		}
+		return u, nil
+	}
+
+	firstname := s.policy.Sanitize(l.firstname)
+	lastname := s.policy.Sanitize(l.lastname)
+	u = &models.Account{
+		Auth: models.Auth{
+			Email:     email,
+			FirstName: firstname,
+			LastName:  lastname,
+			Name:      strings.TrimSpace(firstname + " " + lastname),
+			Verified:  verified,
+		},
+		Sessions: []models.Session{
+			{
+				IP:        ip,
+				Timestamp: time.Now(),
+			},
+		},
+		LoggedIn: time.Now(),
+		Object:   "account",
+	}
+	if verified {
+		u.Auth.VerifiedDate = time.Now()
+	}
+	u.Auth.Picture = l.picture
+	l.attach(u)
+
+	var vtoken string
+	if !verified {
+		vtoken, err = generateToken(32)
+		if err != nil {
+			s.logger.Errorf("%v: %v", prefix, err)
+			return nil, status.Error(codes.Internal, "An Internal error has occurred")
+		}
+		u.Auth.VerificationToken = hashToken(vtoken)
+		u.Auth.VerificationTokenExpires = time.Now().Add(verificationExpiry)
+		u.Auth.VerificationSent = time.Now()
+	}
+
+	id, err := s.accountsRepo.Save(nil, u)
+	if err != nil {
+		s.logger.Errorf("%v: account saving: %v", prefix, err)
+		return nil, status.Error(codes.Internal, "An Internal error has occurred")
+	}
+	u.ID, err = primitive.ObjectIDFromHex(id)
+	if err != nil {
+		s.logger.Errorf("%v: %v", prefix, err)
+		return nil, status.Error(codes.Internal, "An Internal error has occurred")
+	}
+
+	if vtoken != "" {
+		_, err = s.mailSVC.SendAccountVerification(ctx, &mailV1.AccountVerificationRequest{
+			Email:             email,
+			VerificationToken: vtoken,
+		})
+		if err != nil {
+			s.logger.Errorf("%v: mailSVC SendAccountVerification: %v", prefix, err)
+		}
+	}
+	return u, nil
+}
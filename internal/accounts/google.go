@@ -0,0 +1,73 @@
+package accounts
+
+import (
+	"context"
+
+	accountsV1 "github.com/isaiahwong/accounts-go/api/accounts/v1"
+	"github.com/isaiahwong/accounts-go/internal/models"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// StartGoogleLogin is a gRPC handler that returns the Google authorization
+// URL for a login challenge
+func (s *Service) StartGoogleLogin(ctx context.Context, _ *accountsV1.Empty) (*accountsV1.FederatedLoginResponse, error) {
+	api := "StartGoogleLogin: "
+
+	if s.google == nil {
+		return nil, status.Error(codes.Unimplemented, "Google sign-in is not configured")
+	}
+	_, state, nonce, err := s.startFederatedRequest(ctx, "google", &api)
+	if err != nil {
+		return nil, err
+	}
+
+	return &accountsV1.FederatedLoginResponse{
+		RedirectTo: s.google.AuthCodeURL(state, nonce),
+		State:      state,
+	}, nil
+}
+
+// CompleteGoogleLogin is a gRPC handler that exchanges the authorization
+// code returned by Google, signs in or creates the linked account and
+// accepts the login challenge
+func (s *Service) CompleteGoogleLogin(ctx context.Context, req *accountsV1.CompleteFederatedLoginRequest) (*accountsV1.RedirectResponse, error) {
+	api := "CompleteGoogleLogin: "
+
+	if s.google == nil {
+		return nil, status.Error(codes.Unimplemented, "Google sign-in is not configured")
+	}
+	ip, challenge, code, nonce, err := s.completeFederatedRequest(ctx, req, "google", &api)
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := s.google.Login(ctx, code, nonce)
+	if err != nil {
+		s.logger.Errorf("%v: %v", api, err)
+		return nil, status.Error(codes.PermissionDenied, "Google sign-in failed")
+	}
+
+	profile := &models.UserProfile{
+		Provider:    "google",
+		ID:          token.Subject,
+		DisplayName: token.String("name"),
+		Name: models.ProfileName{
+			GivenName:  token.String("given_name"),
+			FamilyName: token.String("family_name"),
+		},
+	}
+	if e := token.String("email"); e != "" {
+		profile.Emails = []models.ProfileEmail{{Value: e, Type: "account"}}
+	}
+	if p := token.String("picture"); p != "" {
+		profile.Photos = []models.ProfilePhoto{{Value: p}}
+	}
+
+	u, err := s.linkFederatedAccount(ctx, profileLink(profile, token.String("email"), token.Bool("email_verified")), ip, api)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.completeLogin(ctx, challenge, u, api)
+}
@@ -0,0 +1,126 @@
+package accounts
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	pb "github.com/isaiahwong/accounts-go/api/accounts/v1"
+	"github.com/isaiahwong/accounts-go/internal/models"
+	"github.com/isaiahwong/accounts-go/internal/oauth"
+	"github.com/isaiahwong/accounts-go/internal/oidc"
+	"github.com/isaiahwong/accounts-go/internal/oidc/oidctest"
+	"github.com/isaiahwong/accounts-go/tests/mocks"
+	"github.com/microcosm-cc/bluemonday"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestGoogleLogin(t *testing.T) {
+	idp := oidctest.NewServer("client", "secret")
+	defer idp.Close()
+
+	var accepted *oauth.HydraLoginAccept
+	hydra, srv := setupHydra(func(w http.ResponseWriter, r *http.Request) {
+		accepted = &oauth.HydraLoginAccept{}
+		json.NewDecoder(r.Body).Decode(accepted)
+		json.NewEncoder(w).Encode(oauth.HydraRedirect{RedirectTo: "https://example.com/callback"})
+	})
+	defer srv.Close()
+
+	newService := func(repo *mocks.Repo) *Service {
+		svc := &Service{
+			logger:        logger,
+			policy:        bluemonday.StrictPolicy(),
+			accountsRepo:  repo,
+			oAuthClient:   hydra,
+			federationKey: []byte("key"),
+			google:        oidc.New(idp.Config("https://example.com/google/callback")),
+		}
+		svc.initValidator()
+		return svc
+	}
+	ctx := incomingContext(
+		XForwardedFor, "127.0.0.1",
+		LoginChallenge, "challenge",
+	)
+	claims := map[string]interface{}{
+		"sub":            "google-123",
+		"email":          "Isaiah@example.com",
+		"email_verified": true,
+		"given_name":     "Isaiah",
+		"family_name":    "Wong",
+		"picture":        "https://example.com/isaiah.png",
+	}
+	authorize := func(t *testing.T, svc *Service) *pb.CompleteFederatedLoginRequest {
+		resp, err := svc.StartGoogleLogin(ctx, &pb.Empty{})
+		if !assert.NoError(t, err) {
+			t.FailNow()
+		}
+		code, state, err := idp.Authorize(resp.GetRedirectTo(), claims)
+		assert.NoError(t, err)
+		assert.Equal(t, resp.GetState(), state)
+		return &pb.CompleteFederatedLoginRequest{Code: code, State: state}
+	}
+
+	t.Run("Creates account", func(t *testing.T) {
+		id := primitive.NewObjectID()
+		var saved *models.Account
+		repo := new(mocks.Repo)
+		repo.On("FindOne", nil, mock.Anything).Return(nil, nil)
+		repo.On("Update", nil, mock.Anything, mock.Anything).Return(1, nil)
+		repo.On("Save", nil, mock.Anything).Run(func(args mock.Arguments) {
+			saved = args.Get(1).(*models.Account)
+		}).Return(id.Hex(), nil)
+		svc := newService(repo)
+
+		resp, err := svc.CompleteGoogleLogin(ctx, authorize(t, svc))
+		assert.NoError(t, err)
+		assert.Equal(t, "https://example.com/callback", resp.GetRedirectTo())
+		assert.Equal(t, id.Hex(), accepted.Subject)
+		assert.Equal(t, "isaiah@example.com", saved.Auth.Email)
+		assert.Equal(t, "Isaiah Wong", saved.Auth.Name)
+		assert.True(t, saved.Auth.Verified)
+		assert.Equal(t, "google-123", saved.Auth.Google.ID)
+	})
+
+	t.Run("Links existing account", func(t *testing.T) {
+		acc := &models.Account{
+			ID:   primitive.NewObjectID(),
+			Auth: models.Auth{Email: "isaiah@example.com", Password: "hash"},
+		}
+		var set bson.M
+		repo := new(mocks.Repo)
+		repo.On("FindOne", nil, bson.M{"auth.google.id": "google-123"}).Return(nil, nil)
+		repo.On("FindOne", nil, mock.Anything).Return(acc, nil)
+		repo.On("Update", nil, bson.M{"_id": acc.ID}, mock.Anything).Run(func(args mock.Arguments) {
+			if set == nil {
+				set = args.Get(2).(bson.M)["$set"].(bson.M)
+			}
+		}).Return(1, nil)
+		svc := newService(repo)
+
+		_, err := svc.CompleteGoogleLogin(ctx, authorize(t, svc))
+		assert.NoError(t, err)
+		assert.Equal(t, acc.ID.Hex(), accepted.Subject)
+		assert.Equal(t, "google-123", set["auth.google"].(*models.UserProfile).ID)
+		// Password of the unverified account is discarded
+		assert.Equal(t, "", set["auth.password"])
+		repo.AssertNotCalled(t, "Save", nil, mock.Anything)
+	})
+
+	t.Run("Invalid state", func(t *testing.T) {
+		svc := newService(new(mocks.Repo))
+		req := authorize(t, svc)
+		other := incomingContext(
+			XForwardedFor, "127.0.0.1",
+			LoginChallenge, "other",
+		)
+		_, err := svc.CompleteGoogleLogin(other, req)
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+}
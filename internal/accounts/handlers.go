@@ -8,13 +8,15 @@ import (
 	"time"
 
 	accountsV1 "github.com/isaiahwong/accounts-go/api/accounts/v1"
+	mailV1 "github.com/isaiahwong/accounts-go/api/mail/v1"
 	"github.com/isaiahwong/accounts-go/internal/common"
 	"github.com/isaiahwong/accounts-go/internal/common/recaptcha"
 	"github.com/isaiahwong/accounts-go/internal/common/validator"
 	"github.com/isaiahwong/accounts-go/internal/models"
 	"github.com/isaiahwong/accounts-go/internal/oauth"
 	"go.mongodb.org/mongo-driver/bson"
-	"golang.org/x/crypto/bcrypt"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -25,8 +27,41 @@ const (
 	CaptchaResponse  = "captcha-response"
 	LoginChallenge   = "login-challenge"
 	ConsentChallenge = "consent-challenge"
+	LogoutChallenge  = "logout-challenge"
+	Authorization    = "authorization"
 )
 
+// passwordResetExpiry defines how long a password reset token is valid for
+const passwordResetExpiry = time.Hour
+
+// verificationExpiry defines how long an email verification token is valid for
+const verificationExpiry = 24 * time.Hour
+
+// magicLinkExpiry defines how long a magic link is valid for
+const magicLinkExpiry = 15 * time.Minute
+
+// loginCodeExpiry defines how long an emailed login code is valid for
+const loginCodeExpiry = 10 * time.Minute
+
+// loginCodeAttempts defines how many times a login code may be guessed
+// before it is locked
+const loginCodeAttempts = 5
+
+// loginCodeLockout defines how long login codes cannot be requested once
+// a code has been locked
+const loginCodeLockout = 15 * time.Minute
+
+// loginCodeDigits defines the length of login codes
+const loginCodeDigits = 6
+
+// pendingLoginExpiry defines how long a login challenge can wait on
+// additional steps such as a second factor
+const pendingLoginExpiry = 5 * time.Minute
+
+// verificationResendInterval defines the minimum duration between
+// verification emails sent to an account
+const verificationResendInterval = 2 * time.Minute
+
 func (s *Service) LoginWithChallenge(ctx context.Context, _ *accountsV1.Empty) (*accountsV1.HydraResponse, error) {
 	api := "LoginWithChallenge: "
 
@@ -147,20 +182,20 @@ func (s *Service) ConsentWithChallenge(ctx context.Context, req *accountsV1.Empt
 		Remember:                 true,
 		RememberFor:              0,
 		Session: oauth.Session{
-			IDToken: map[string]string{
-				"email":       u.Auth.Email,
-				"given_name":  u.Auth.FirstName,
-				"family_name": u.Auth.LastName,
-				"name":        u.Auth.Name,
-				"picture":     u.Auth.Picture,
-				"account_id":  u.ID.Hex(),
-				// "email_verified": true,
+			IDToken: map[string]interface{}{
+				"email":                 u.Auth.Email,
+				"email_verified":        u.Auth.Verified,
+				"given_name":            u.Auth.FirstName,
+				"family_name":           u.Auth.LastName,
+				"name":                  u.Auth.Name,
+				"picture":               u.Auth.Picture,
+				"account_id":            u.ID.Hex(),
+				"phone_number":          u.Phone.Number,
+				"phone_number_verified": u.Phone.Verified,
 				// "gender": "string",
 				// "locale": "string",
 				// "middle_name": "string",
 				// "nickname": "string",
-				// "phone_number": "string",
-				// "phone_number_verified": true,
 				// "picture": "string",
 				// "preferred_username": "string",
 				// "profile": "string",
@@ -178,6 +213,183 @@ func (s *Service) ConsentWithChallenge(ctx context.Context, req *accountsV1.Empt
 	return &accountsV1.RedirectResponse{RedirectTo: r.RedirectTo}, nil
 }
 
+// LogoutWithChallenge is a gRPC handler that returns the logout request of a
+// challenge so the account can be asked to confirm it
+func (s *Service) LogoutWithChallenge(ctx context.Context, _ *accountsV1.Empty) (*accountsV1.HydraResponse, error) {
+	api := "LogoutWithChallenge: "
+
+	challenge := common.GetMetadataValue(ctx, LogoutChallenge)
+	ip := common.GetMetadataValue(ctx, XForwardedFor)
+
+	errs := validator.Val(
+		s.validate,
+		validator.Field{
+			Param:   LogoutChallenge,
+			Message: LogoutChallenge + " header required",
+			Value:   challenge,
+			Tag:     `required`,
+		},
+		validator.Field{
+			Param:   XForwardedFor,
+			Message: XForwardedFor + " header required",
+			Value:   ip,
+			Tag:     `required`,
+		},
+	)
+
+	// Validate
+	if len(errs) > 0 {
+		return nil, s.returnErrors(ctx, errs, codes.InvalidArgument, "Malformed request", api)
+	}
+	// Prepend IP for logging
+	api = fmt.Sprintf("[%v] %v", ip, api)
+
+	resp, err := s.oAuthClient.Logout(challenge)
+	if err != nil {
+		s.logger.Errorf("%v: %v", api, err)
+		// Cast  to hydra error
+		if he, ok := err.(*oauth.HydraError); ok {
+			return nil, s.returnHydraError(ctx, he, api)
+		}
+		return nil, status.Error(codes.Internal, "An Internal error has occurred")
+	}
+
+	return &accountsV1.HydraResponse{
+		Challenge:  challenge,
+		RequestUrl: resp.RequestURL,
+		SessionId:  resp.SID,
+		Subject:    resp.Subject,
+	}, nil
+}
+
+// AcceptLogout is a gRPC handler that accepts a logout challenge and records
+// the logout against the account's sessions
+func (s *Service) AcceptLogout(ctx context.Context, _ *accountsV1.Empty) (*accountsV1.RedirectResponse, error) {
+	api := "AcceptLogout: "
+
+	challenge := common.GetMetadataValue(ctx, LogoutChallenge)
+	ip := common.GetMetadataValue(ctx, XForwardedFor)
+
+	errs := validator.Val(
+		s.validate,
+		validator.Field{
+			Param:   LogoutChallenge,
+			Message: LogoutChallenge + " header required",
+			Value:   challenge,
+			Tag:     `required`,
+		},
+		validator.Field{
+			Param:   XForwardedFor,
+			Message: XForwardedFor + " header required",
+			Value:   ip,
+			Tag:     `required`,
+		},
+	)
+
+	// Validate
+	if len(errs) > 0 {
+		return nil, s.returnErrors(ctx, errs, codes.InvalidArgument, "Malformed request", api)
+	}
+	// Prepend IP for logging
+	api = fmt.Sprintf("[%v] %v", ip, api)
+
+	resp, err := s.oAuthClient.Logout(challenge)
+	if err != nil {
+		s.logger.Errorf("%v: %v", api, err)
+		// Cast  to hydra error
+		if he, ok := err.(*oauth.HydraError); ok {
+			return nil, s.returnHydraError(ctx, he, api)
+		}
+		return nil, status.Error(codes.Internal, "An Internal error has occurred")
+	}
+
+	r, err := s.oAuthClient.AcceptLogout(challenge)
+	if err != nil {
+		s.logger.Errorf("%v: %v", api, err)
+		// Cast  to hydra error
+		if he, ok := err.(*oauth.HydraError); ok {
+			return nil, s.returnHydraError(ctx, he, api)
+		}
+		return nil, status.Error(codes.Internal, "An Internal error has occurred")
+	}
+
+	// Record logout. The subject is empty when the browser had no
+	// authenticated session with Hydra.
+	if oid, err := primitive.ObjectIDFromHex(resp.Subject); err == nil {
+		_, err = s.accountsRepo.Update(
+			nil,
+			bson.M{"_id": oid},
+			bson.M{
+				"$set": bson.M{
+					"updated_at": time.Now(),
+					"logged_out": time.Now(),
+				},
+				"$push": bson.M{
+					"sessions": models.Session{
+						IP:        ip,
+						SID:       resp.SID,
+						Timestamp: time.Now(),
+						LoggedOut: time.Now(),
+					},
+				},
+			},
+		)
+		// Hydra has already logged the subject out
+		if err != nil {
+			s.logger.Errorf("%v: %v", api, err)
+		}
+	}
+
+	return &accountsV1.RedirectResponse{RedirectTo: r.RedirectTo}, nil
+}
+
+// RejectLogout is a gRPC handler that rejects a logout challenge. This is
+// used when the account denies the logout request.
+func (s *Service) RejectLogout(ctx context.Context, _ *accountsV1.Empty) (*accountsV1.Empty, error) {
+	api := "RejectLogout: "
+
+	challenge := common.GetMetadataValue(ctx, LogoutChallenge)
+	ip := common.GetMetadataValue(ctx, XForwardedFor)
+
+	errs := validator.Val(
+		s.validate,
+		validator.Field{
+			Param:   LogoutChallenge,
+			Message: LogoutChallenge + " header required",
+			Value:   challenge,
+			Tag:     `required`,
+		},
+		validator.Field{
+			Param:   XForwardedFor,
+			Message: XForwardedFor + " header required",
+			Value:   ip,
+			Tag:     `required`,
+		},
+	)
+
+	// Validate
+	if len(errs) > 0 {
+		return nil, s.returnErrors(ctx, errs, codes.InvalidArgument, "Malformed request", api)
+	}
+	// Prepend IP for logging
+	api = fmt.Sprintf("[%v] %v", ip, api)
+
+	_, err := s.oAuthClient.RejectLogout(challenge, &oauth.HydraError{
+		ErrorName:        "access_denied",
+		ErrorDescription: "The resource owner denied the logout request",
+	})
+	if err != nil {
+		s.logger.Errorf("%v: %v", api, err)
+		// Cast  to hydra error
+		if he, ok := err.(*oauth.HydraError); ok {
+			return nil, s.returnHydraError(ctx, he, api)
+		}
+		return nil, status.Error(codes.Internal, "An Internal error has occurred")
+	}
+
+	return &accountsV1.Empty{}, nil
+}
+
 func (s *Service) Introspect(ctx context.Context, req *accountsV1.IntrospectRequest) (*accountsV1.IntrospectResponse, error) {
 	api := "Introspect: "
 	token := req.GetToken()
@@ -293,8 +505,34 @@ func (s *Service) AccountExists(ctx context.Context, req *accountsV1.AccountExis
 	}, nil
 }
 
+// IsAuthenticated is a gRPC handler that resolves the bearer token in the
+// authorization header to the account it was issued to
 func (s *Service) IsAuthenticated(ctx context.Context, in *accountsV1.Empty) (*accountsV1.AuthenticateResponse, error) {
-	return nil, nil
+	api := "IsAuthenticated: "
+
+	token, err := s.validateBearer(ctx, &api)
+	if err != nil {
+		return nil, err
+	}
+
+	u, resp, err := s.accountFromToken(ctx, token, api)
+	if err != nil {
+		return nil, err
+	}
+
+	return &accountsV1.AuthenticateResponse{
+		Status:        true,
+		Subject:       resp.Sub,
+		Scopes:        strings.Fields(resp.Scope),
+		ClientId:      resp.ClientID,
+		Exp:           resp.Exp,
+		Email:         u.Auth.Email,
+		EmailVerified: u.Auth.Verified,
+		FirstName:     u.Auth.FirstName,
+		LastName:      u.Auth.LastName,
+		Name:          u.Auth.Name,
+		Picture:       u.Auth.Picture,
+	}, nil
 }
 
 // SignUp is a gRPC handler allows account to register
@@ -307,8 +545,8 @@ func (s *Service) SignUp(ctx context.Context, req *accountsV1.SignUpRequest) (*a
 	email := strings.ToLower(strings.TrimSpace(req.GetEmail()))
 	firstname := strings.TrimSpace(req.GetFirstName())
 	lastname := strings.TrimSpace(req.GetLastName())
-	password := strings.TrimSpace(req.GetPassword())
-	cpassword := strings.TrimSpace(req.GetConfirmPassword())
+	password := s.passwordPolicy.Normalize(strings.TrimSpace(req.GetPassword()))
+	cpassword := s.passwordPolicy.Normalize(strings.TrimSpace(req.GetConfirmPassword()))
 
 	errs := validator.Val(
 		s.validate,
@@ -330,12 +568,6 @@ func (s *Service) SignUp(ctx context.Context, req *accountsV1.SignUpRequest) (*a
 			Value:   email,
 			Tag:     "required,email,emailMX,max=64",
 		},
-		validator.Field{
-			Param:   "password",
-			Message: "Password invalid",
-			Value:   password,
-			Tag:     "required,min=8,max=64,containsany=\"!\"#$%&'()*+0x2C-./:;<=>?@[]^_`{0x7C}~\"", // Use the UTF-8 hex representation for pipe "|" is 0x7C and comma "," 0x2C
-		},
 		validator.Field{
 			Param:      "confirm_password",
 			Message:    "Passwords do not match",
@@ -362,6 +594,7 @@ func (s *Service) SignUp(ctx context.Context, req *accountsV1.SignUpRequest) (*a
 			Tag:     `required`,
 		},
 	)
+	errs = append(errs, s.checkPassword(password, email, firstname, lastname)...)
 	// Validate
 	if len(errs) > 0 {
 		return nil, s.returnErrors(ctx, errs, codes.InvalidArgument, "Malformed request", api)
@@ -407,8 +640,20 @@ func (s *Service) SignUp(ctx context.Context, req *accountsV1.SignUpRequest) (*a
 		}, codes.AlreadyExists, "Email is already in used", api)
 	}
 
+	// Reject passwords known from breaches
+	if err := s.screenPassword(ctx, password, api); err != nil {
+		return nil, err
+	}
+
 	// Hash password
-	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	hash, method, err := s.hashers.Hash(password)
+	if err != nil {
+		s.logger.Errorf("%v: %v", api, err)
+		return nil, status.Error(codes.Internal, "An Internal error has occurred")
+	}
+
+	// Issue email verification token
+	vtoken, err := generateToken(32)
 	if err != nil {
 		s.logger.Errorf("%v: %v", api, err)
 		return nil, status.Error(codes.Internal, "An Internal error has occurred")
@@ -417,11 +662,16 @@ func (s *Service) SignUp(ctx context.Context, req *accountsV1.SignUpRequest) (*a
 	// Build Account model
 	u = &models.Account{
 		Auth: models.Auth{
-			Email:     email,
-			Password:  string(hash),
-			FirstName: firstname,
-			LastName:  lastname,
-			Name:      firstname + " " + lastname,
+			Email:                    email,
+			Password:                 hash,
+			PasswordHashMethod:       method,
+			PasswordModified:         time.Now(),
+			FirstName:                firstname,
+			LastName:                 lastname,
+			Name:                     firstname + " " + lastname,
+			VerificationToken:        hashToken(vtoken),
+			VerificationTokenExpires: time.Now().Add(verificationExpiry),
+			VerificationSent:         time.Now(),
 		},
 		Sessions: []models.Session{
 			{
@@ -438,6 +688,16 @@ func (s *Service) SignUp(ctx context.Context, req *accountsV1.SignUpRequest) (*a
 		return nil, status.Error(codes.Internal, "An Internal error has occurred")
 	}
 
+	// The account has been created at this point, the verification email
+	// can be resent if it fails
+	_, err = s.mailSVC.SendAccountVerification(ctx, &mailV1.AccountVerificationRequest{
+		Email:             email,
+		VerificationToken: vtoken,
+	})
+	if err != nil {
+		s.logger.Errorf("%v: mailSVC SendAccountVerification: %v", api, err)
+	}
+
 	// Authenticate with Hydra
 	r, err := s.oAuthClient.AcceptLogin(challenge, &oauth.HydraLoginAccept{
 		Subject:     id,
@@ -490,6 +750,12 @@ func (s *Service) Authenticate(ctx context.Context, req *accountsV1.Authenticate
 			Value:   ip,
 			Tag:     `required`,
 		},
+		validator.Field{
+			Param:   LoginChallenge,
+			Message: LoginChallenge + " header required",
+			Value:   challenge,
+			Tag:     `required`,
+		},
 	)
 	// Validate
 	if len(errs) > 0 {
@@ -499,13 +765,8 @@ func (s *Service) Authenticate(ctx context.Context, req *accountsV1.Authenticate
 	api = fmt.Sprintf("[%v] %v", ip, api)
 
 	// Verify reCAPTCHA
-	if s.production {
-		// Get ip via headers
-		rcpResp, err := recaptcha.Verify(captchaResponse, ip)
-		if err != nil || !rcpResp.Success {
-			s.logger.Errorf("%v: recaptcha verify: %v", api, err)
-			return nil, status.Error(codes.InvalidArgument, "Captcha Verification Failed")
-		}
+	if err := s.verifyCaptcha(captchaResponse, ip, api); err != nil {
+		return nil, err
 	}
 
 	// Retrieve account
@@ -524,8 +785,25 @@ func (s *Service) Authenticate(ctx context.Context, req *accountsV1.Authenticate
 		}, codes.PermissionDenied, "Wrong email or password", api)
 	}
 
-	// TODO: Add time constant for comparing hash
-	if err := bcrypt.CompareHashAndPassword([]byte(u.Auth.Password), []byte(password)); err != nil {
+	// Locked accounts are refused before the password is checked
+	if time.Now().Before(u.Lockout.LockedUntil) {
+		return nil, s.lockedError(ctx, u.Lockout.LockedUntil, api)
+	}
+
+	ok, rehash, err := s.verifyPassword(u, password)
+	if err != nil {
+		s.logger.Errorf("%v: %v", api, err)
+		return nil, status.Error(codes.Internal, "An Internal error has occurred")
+	}
+	if !ok {
+		locked, until, err := s.recordFailedLogin(u, api)
+		if err != nil {
+			s.logger.Errorf("%v: %v", api, err)
+			return nil, status.Error(codes.Internal, "An Internal error has occurred")
+		}
+		if locked {
+			return nil, s.lockedError(ctx, until, api)
+		}
 		return nil, s.returnErrors(ctx, []validator.Error{
 			{
 				Param:   "password",
@@ -534,43 +812,41 @@ func (s *Service) Authenticate(ctx context.Context, req *accountsV1.Authenticate
 		}, codes.PermissionDenied, "Wrong email or password", api)
 	}
 
-	// Authenticate via Hydra
-	r, err := s.oAuthClient.AcceptLogin(challenge, &oauth.HydraLoginAccept{
-		Subject:     u.ID.Hex(),
-		Remember:    true,
-		RememberFor: 0, // TODO: Change with env variable
-	})
-	if err != nil {
-		s.logger.Errorf("%v: oAuthClient AcceptLogin: %v", api, err)
+	if err := s.resetFailedLogins(u); err != nil {
+		s.logger.Errorf("%v: %v", api, err)
 		return nil, status.Error(codes.Internal, "An Internal error has occurred")
 	}
 
-	// Add Device Session
-	u.LoggedIn = time.Now()
-	_, err = s.accountsRepo.Update(
-		nil,
-		bson.M{"_id": u.ID},
-		bson.M{
-			"$set": bson.M{
-				"updated_at": time.Now(),
-				"logged_in":  time.Now(),
-			},
-		},
-	)
-	if err != nil {
-		s.logger.Errorf("%v: %v", api, err)
-		return nil, status.Error(codes.Internal, "An Internal error has occurred")
+	// Upgrade hashes created with an outdated algorithm or parameters. The
+	// login succeeds regardless.
+	if rehash {
+		if err := s.rehashPassword(u, s.passwordPolicy.Normalize(password)); err != nil {
+			s.logger.Errorf("%v: rehash: %v", api, err)
+		}
 	}
 
-	return &accountsV1.RedirectResponse{RedirectTo: r.RedirectTo}, nil
+	// Expired passwords must be changed before the login completes
+	if s.passwordExpired(u) {
+		if err := s.setPasswordChangeLogin(u, challenge, oauth.AMRPassword); err != nil {
+			s.logger.Errorf("%v: %v", api, err)
+			return nil, status.Error(codes.Internal, "An Internal error has occurred")
+		}
+		return &accountsV1.RedirectResponse{PasswordChangeRequired: true}, nil
+	}
+
+	// Authenticate via Hydra, requiring a second factor when enabled
+	return s.completeLogin(ctx, challenge, u, api, oauth.AMRPassword)
 }
 
-func (s *Service) EmailExists(ctx context.Context, req *accountsV1.EmailExistsRequest) (*accountsV1.EmailExistsResponse, error) {
-	api := "EmailExists: "
+// RequestLoginCode is a gRPC handler that mails a numeric one time code for
+// the login challenge to the account's email
+func (s *Service) RequestLoginCode(ctx context.Context, req *accountsV1.LoginCodeRequest) (*accountsV1.Empty, error) {
+	api := "RequestLoginCode: "
 
-	email := strings.ToLower(strings.TrimSpace(req.GetEmail()))
 	ip := common.GetMetadataValue(ctx, XForwardedFor)
-	captchaResponse := common.GetMetadataValue(ctx, "captcha-response")
+	captchaResponse := common.GetMetadataValue(ctx, CaptchaResponse)
+	challenge := common.GetMetadataValue(ctx, LoginChallenge)
+	email := strings.ToLower(strings.TrimSpace(req.GetEmail()))
 
 	errs := validator.Val(
 		s.validate,
@@ -581,8 +857,8 @@ func (s *Service) EmailExists(ctx context.Context, req *accountsV1.EmailExistsRe
 			Tag:     "required,email,emailMX,max=64",
 		},
 		validator.Field{
-			Param:   "captcha-response",
-			Message: "captcha-response header required",
+			Param:   CaptchaResponse,
+			Message: CaptchaResponse + " header required",
 			Value:   captchaResponse,
 			Tag:     `required`,
 		},
@@ -592,29 +868,667 @@ func (s *Service) EmailExists(ctx context.Context, req *accountsV1.EmailExistsRe
 			Value:   ip,
 			Tag:     `required`,
 		},
+		validator.Field{
+			Param:   LoginChallenge,
+			Message: LoginChallenge + " header required",
+			Value:   challenge,
+			Tag:     `required`,
+		},
 	)
-
 	// Validate
 	if len(errs) > 0 {
 		return nil, s.returnErrors(ctx, errs, codes.InvalidArgument, "Malformed request", api)
 	}
+	// Prepend IP for logging
+	api = fmt.Sprintf("[%v] %v", ip, api)
 
 	// Verify reCAPTCHA
-	if s.production {
-		// Get ip via headers
-		rcpResp, err := recaptcha.Verify(captchaResponse, ip)
-		if err != nil || !rcpResp.Success {
-			s.logger.Errorf("%v: recaptcha verify: %v", api, err)
-			return nil, status.Error(codes.InvalidArgument, "Captcha Verification Failed")
-		}
+	if err := s.verifyCaptcha(captchaResponse, ip, api); err != nil {
+		return nil, err
 	}
 
-	// Check if email exists
 	u, err := s.findAccountByEmail(nil, email)
 	if err != nil {
 		s.logger.Errorf("%v: %v", api, err)
 		return nil, status.Error(codes.Internal, "An Internal error has occurred")
 	}
+	// Respond the same way whether or not the account exists or is locked
+	// so the endpoint cannot be used to enumerate emails
+	if u == nil {
+		s.logger.Warnf("%v: no account for %v", api, email)
+		return &accountsV1.Empty{}, nil
+	}
+	if time.Now().Before(u.LoginCode.LockedUntil) {
+		s.logger.Warnf("%v: login code locked for %v", api, u.ID.Hex())
+		return &accountsV1.Empty{}, nil
+	}
 
-	return &accountsV1.EmailExistsResponse{Exist: u != nil}, nil
+	code, err := generateCode(loginCodeDigits)
+	if err != nil {
+		s.logger.Errorf("%v: %v", api, err)
+		return nil, status.Error(codes.Internal, "An Internal error has occurred")
+	}
+
+	// A new code replaces any code issued before. The code is digested
+	// with the challenge as it is short enough to be reversed otherwise.
+	_, err = s.accountsRepo.Update(
+		nil,
+		bson.M{"_id": u.ID},
+		bson.M{
+			"$set": bson.M{
+				"updated_at":                 time.Now(),
+				"login_code.code":            hashToken(challenge + code),
+				"login_code.login_challenge": hashToken(challenge),
+				"login_code.expires":         time.Now().Add(loginCodeExpiry),
+				"login_code.attempts":        0,
+			},
+		},
+	)
+	if err != nil {
+		s.logger.Errorf("%v: %v", api, err)
+		return nil, status.Error(codes.Internal, "An Internal error has occurred")
+	}
+
+	_, err = s.mailSVC.SendLoginCode(ctx, &mailV1.LoginCodeRequest{
+		Email: u.Auth.Email,
+		Code:  code,
+	})
+	if err != nil {
+		s.logger.Errorf("%v: mailSVC SendLoginCode: %v", api, err)
+		return nil, status.Error(codes.Internal, "An Internal error has occurred")
+	}
+
+	return &accountsV1.Empty{}, nil
+}
+
+// VerifyLoginCode is a gRPC handler that accepts the login challenge given
+// the code mailed for it. Codes can only be used once and are locked after
+// loginCodeAttempts wrong guesses.
+func (s *Service) VerifyLoginCode(ctx context.Context, req *accountsV1.VerifyLoginCodeRequest) (*accountsV1.RedirectResponse, error) {
+	api := "VerifyLoginCode: "
+
+	ip := common.GetMetadataValue(ctx, XForwardedFor)
+	challenge := common.GetMetadataValue(ctx, LoginChallenge)
+	code := strings.TrimSpace(req.GetCode())
+
+	errs := validator.Val(
+		s.validate,
+		validator.Field{
+			Param:          "code",
+			Message:        "Invalid code",
+			Value:          code,
+			Tag:            fmt.Sprintf("required,numeric,len=%d", loginCodeDigits),
+			OmitParamValue: true,
+		},
+		validator.Field{
+			Param:   XForwardedFor,
+			Message: XForwardedFor + " header required",
+			Value:   ip,
+			Tag:     `required`,
+		},
+		validator.Field{
+			Param:   LoginChallenge,
+			Message: LoginChallenge + " header required",
+			Value:   challenge,
+			Tag:     `required`,
+		},
+	)
+	// Validate
+	if len(errs) > 0 {
+		return nil, s.returnErrors(ctx, errs, codes.InvalidArgument, "Malformed request", api)
+	}
+	// Prepend IP for logging
+	api = fmt.Sprintf("[%v] %v", ip, api)
+
+	invalidCode := []validator.Error{
+		{
+			Param:   "code",
+			Message: "Code is invalid or has expired",
+		},
+	}
+
+	digest := hashToken(challenge)
+	u, err := s.accountsRepo.FindOne(nil, bson.M{"login_code.login_challenge": digest})
+	if err != nil {
+		s.logger.Errorf("%v: %v", api, err)
+		return nil, status.Error(codes.Internal, "An Internal error has occurred")
+	}
+	if u == nil || time.Now().After(u.LoginCode.Expires) {
+		return nil, s.returnErrors(ctx, invalidCode, codes.PermissionDenied, "Invalid code", api)
+	}
+
+	// Claim an attempt before comparing so concurrent guesses cannot exceed
+	// the limit
+	_, err = s.accountsRepo.Update(
+		nil,
+		bson.M{
+			"_id":                        u.ID,
+			"login_code.login_challenge": digest,
+			"login_code.attempts":        bson.M{"$lt": loginCodeAttempts},
+		},
+		bson.M{
+			"$set": bson.M{"updated_at": time.Now()},
+			"$inc": bson.M{"login_code.attempts": 1},
+		},
+	)
+	if err == mongo.ErrNoDocuments {
+		return nil, s.returnErrors(ctx, []validator.Error{
+			{
+				Param:   "code",
+				Message: "Too many attempts. Request a new code later",
+			},
+		}, codes.PermissionDenied, "Too many attempts", api)
+	}
+	if err != nil {
+		s.logger.Errorf("%v: %v", api, err)
+		return nil, status.Error(codes.Internal, "An Internal error has occurred")
+	}
+
+	if !compareToken(challenge+code, u.LoginCode.Code) {
+		if u.LoginCode.Attempts+1 >= loginCodeAttempts {
+			_, err = s.accountsRepo.Update(
+				nil,
+				bson.M{"_id": u.ID},
+				bson.M{
+					"$set": bson.M{
+						"updated_at":              time.Now(),
+						"login_code.locked_until": time.Now().Add(loginCodeLockout),
+					},
+				},
+			)
+			if err != nil {
+				s.logger.Errorf("%v: %v", api, err)
+			}
+		}
+		return nil, s.returnErrors(ctx, invalidCode, codes.PermissionDenied, "Invalid code", api)
+	}
+
+	// Match on the code digest so concurrent requests cannot both use the
+	// same code
+	_, err = s.accountsRepo.Update(
+		nil,
+		bson.M{
+			"_id":             u.ID,
+			"login_code.code": u.LoginCode.Code,
+		},
+		bson.M{
+			"$set": bson.M{
+				"updated_at":                 time.Now(),
+				"login_code.code":            "",
+				"login_code.login_challenge": "",
+				"login_code.expires":         time.Time{},
+				"login_code.attempts":        0,
+			},
+		},
+	)
+	if err == mongo.ErrNoDocuments {
+		return nil, s.returnErrors(ctx, invalidCode, codes.PermissionDenied, "Invalid code", api)
+	}
+	if err != nil {
+		s.logger.Errorf("%v: %v", api, err)
+		return nil, status.Error(codes.Internal, "An Internal error has occurred")
+	}
+
+	return s.completeLogin(ctx, challenge, u, api, oauth.AMROTP)
+}
+
+func (s *Service) EmailExists(ctx context.Context, req *accountsV1.EmailExistsRequest) (*accountsV1.EmailExistsResponse, error) {
+	api := "EmailExists: "
+
+	email := strings.ToLower(strings.TrimSpace(req.GetEmail()))
+	ip := common.GetMetadataValue(ctx, XForwardedFor)
+	captchaResponse := common.GetMetadataValue(ctx, "captcha-response")
+
+	errs := validator.Val(
+		s.validate,
+		validator.Field{
+			Param:   "email",
+			Message: "Invalid email",
+			Value:   email,
+			Tag:     "required,email,emailMX,max=64",
+		},
+		validator.Field{
+			Param:   "captcha-response",
+			Message: "captcha-response header required",
+			Value:   captchaResponse,
+			Tag:     `required`,
+		},
+		validator.Field{
+			Param:   XForwardedFor,
+			Message: XForwardedFor + " header required",
+			Value:   ip,
+			Tag:     `required`,
+		},
+	)
+
+	// Validate
+	if len(errs) > 0 {
+		return nil, s.returnErrors(ctx, errs, codes.InvalidArgument, "Malformed request", api)
+	}
+
+	// Verify reCAPTCHA
+	if s.production {
+		// Get ip via headers
+		rcpResp, err := recaptcha.Verify(captchaResponse, ip)
+		if err != nil || !rcpResp.Success {
+			s.logger.Errorf("%v: recaptcha verify: %v", api, err)
+			return nil, status.Error(codes.InvalidArgument, "Captcha Verification Failed")
+		}
+	}
+
+	// Check if email exists
+	u, err := s.findAccountByEmail(nil, email)
+	if err != nil {
+		s.logger.Errorf("%v: %v", api, err)
+		return nil, status.Error(codes.Internal, "An Internal error has occurred")
+	}
+
+	return &accountsV1.EmailExistsResponse{Exist: u != nil}, nil
+}
+
+// RequestPasswordReset is a gRPC handler that issues a password reset token
+// and mails it to the account's email
+func (s *Service) RequestPasswordReset(ctx context.Context, req *accountsV1.PasswordResetRequest) (*accountsV1.Empty, error) {
+	api := "RequestPasswordReset: "
+
+	email := strings.ToLower(strings.TrimSpace(req.GetEmail()))
+	ip := common.GetMetadataValue(ctx, XForwardedFor)
+	captchaResponse := common.GetMetadataValue(ctx, CaptchaResponse)
+
+	errs := validator.Val(
+		s.validate,
+		validator.Field{
+			Param:   "email",
+			Message: "Invalid email",
+			Value:   email,
+			Tag:     "required,email,emailMX,max=64",
+		},
+		validator.Field{
+			Param:   CaptchaResponse,
+			Message: CaptchaResponse + " header required",
+			Value:   captchaResponse,
+			Tag:     `required`,
+		},
+		validator.Field{
+			Param:   XForwardedFor,
+			Message: XForwardedFor + " header required",
+			Value:   ip,
+			Tag:     `required`,
+		},
+	)
+	// Validate
+	if len(errs) > 0 {
+		return nil, s.returnErrors(ctx, errs, codes.InvalidArgument, "Malformed request", api)
+	}
+	// Prepend IP for logging
+	api = fmt.Sprintf("[%v] %v", ip, api)
+
+	// Verify reCAPTCHA
+	if s.production {
+		rcpResp, err := recaptcha.Verify(captchaResponse, ip)
+		if err != nil || !rcpResp.Success {
+			s.logger.Errorf("%v: recaptcha verify: %v", api, err)
+			return nil, status.Error(codes.InvalidArgument, "Captcha Verification Failed")
+		}
+	}
+
+	u, err := s.findAccountByEmail(nil, email)
+	if err != nil {
+		s.logger.Errorf("%v: %v", api, err)
+		return nil, status.Error(codes.Internal, "An Internal error has occurred")
+	}
+	// Respond the same way whether or not the account exists so the
+	// endpoint cannot be used to enumerate emails
+	if u == nil {
+		s.logger.Warnf("%v: no account for %v", api, email)
+		return &accountsV1.Empty{}, nil
+	}
+
+	id, err := generateToken(16)
+	if err != nil {
+		s.logger.Errorf("%v: %v", api, err)
+		return nil, status.Error(codes.Internal, "An Internal error has occurred")
+	}
+	token, err := generateToken(32)
+	if err != nil {
+		s.logger.Errorf("%v: %v", api, err)
+		return nil, status.Error(codes.Internal, "An Internal error has occurred")
+	}
+
+	_, err = s.accountsRepo.Update(
+		nil,
+		bson.M{"_id": u.ID},
+		bson.M{
+			"$set": bson.M{
+				"updated_at":                  time.Now(),
+				"auth.password_reset_id":      id,
+				"auth.password_reset_token":   hashToken(token),
+				"auth.password_reset_expires": time.Now().Add(passwordResetExpiry),
+			},
+		},
+	)
+	if err != nil {
+		s.logger.Errorf("%v: %v", api, err)
+		return nil, status.Error(codes.Internal, "An Internal error has occurred")
+	}
+
+	_, err = s.mailSVC.SendResetPassword(ctx, &mailV1.ResetPasswordRequest{
+		Email:      u.Auth.Email,
+		PasswordId: id,
+		Token:      token,
+	})
+	if err != nil {
+		s.logger.Errorf("%v: mailSVC SendResetPassword: %v", api, err)
+		return nil, status.Error(codes.Internal, "An Internal error has occurred")
+	}
+
+	return &accountsV1.Empty{}, nil
+}
+
+// ConfirmPasswordReset is a gRPC handler that sets a new password given a
+// valid password reset token. Tokens can only be used once.
+func (s *Service) ConfirmPasswordReset(ctx context.Context, req *accountsV1.ConfirmPasswordResetRequest) (*accountsV1.Empty, error) {
+	api := "ConfirmPasswordReset: "
+
+	ip := common.GetMetadataValue(ctx, XForwardedFor)
+	id := strings.TrimSpace(req.GetId())
+	token := strings.TrimSpace(req.GetToken())
+	password := s.passwordPolicy.Normalize(strings.TrimSpace(req.GetPassword()))
+	cpassword := s.passwordPolicy.Normalize(strings.TrimSpace(req.GetConfirmPassword()))
+
+	errs := validator.Val(
+		s.validate,
+		validator.Field{
+			Param:   "id",
+			Message: "Invalid password reset id",
+			Value:   id,
+			Tag:     "required",
+		},
+		validator.Field{
+			Param:          "token",
+			Message:        "Invalid password reset token",
+			Value:          token,
+			Tag:            "required",
+			OmitParamValue: true,
+		},
+		validator.Field{
+			Param:          "confirm_password",
+			Message:        "Passwords do not match",
+			Value:          cpassword,
+			OtherValue:     password,
+			Tag:            `eqfield`,
+			OmitParamValue: true,
+		},
+		validator.Field{
+			Param:   XForwardedFor,
+			Message: XForwardedFor + " header required",
+			Value:   ip,
+			Tag:     `required`,
+		},
+	)
+	errs = append(errs, s.checkPassword(password)...)
+	// Validate
+	if len(errs) > 0 {
+		return nil, s.returnErrors(ctx, errs, codes.InvalidArgument, "Malformed request", api)
+	}
+	// Prepend IP for logging
+	api = fmt.Sprintf("[%v] %v", ip, api)
+
+	invalidToken := []validator.Error{
+		{
+			Param:   "token",
+			Message: "Password reset token is invalid or has expired",
+		},
+	}
+
+	u, err := s.accountsRepo.FindOne(nil, bson.M{"auth.password_reset_id": id})
+	if err != nil {
+		s.logger.Errorf("%v: %v", api, err)
+		return nil, status.Error(codes.Internal, "An Internal error has occurred")
+	}
+	if u == nil ||
+		!compareToken(token, u.Auth.PasswordResetToken) ||
+		time.Now().After(u.Auth.PasswordResetExpires) {
+		return nil, s.returnErrors(ctx, invalidToken, codes.InvalidArgument, "Invalid token", api)
+	}
+	// Personal details are only known once the account is found
+	if errs := s.checkPassword(password, u.Auth.Email, u.Auth.FirstName, u.Auth.LastName); len(errs) > 0 {
+		return nil, s.returnErrors(ctx, errs, codes.InvalidArgument, "Malformed request", api)
+	}
+
+	// Reject passwords known from breaches
+	if err := s.screenPassword(ctx, password, api); err != nil {
+		return nil, err
+	}
+	if err := s.checkPasswordReuse(ctx, u, password, api); err != nil {
+		return nil, err
+	}
+
+	// Hash password
+	hash, method, err := s.hashers.Hash(password)
+	if err != nil {
+		s.logger.Errorf("%v: %v", api, err)
+		return nil, status.Error(codes.Internal, "An Internal error has occurred")
+	}
+
+	// Match on the token digest so concurrent requests cannot both
+	// consume the same token
+	_, err = s.accountsRepo.Update(
+		nil,
+		bson.M{
+			"_id":                       u.ID,
+			"auth.password_reset_token": u.Auth.PasswordResetToken,
+		},
+		bson.M{
+			"$set": bson.M{
+				"updated_at":                  time.Now(),
+				"auth.password":               hash,
+				"auth.password_hash_method":   method,
+				"auth.password_modified":      time.Now(),
+				"auth.password_history":       s.nextPasswordHistory(u),
+				"auth.password_reset_id":      "",
+				"auth.password_reset_token":   "",
+				"auth.password_reset_expires": time.Time{},
+			},
+		},
+	)
+	if err == mongo.ErrNoDocuments {
+		return nil, s.returnErrors(ctx, invalidToken, codes.InvalidArgument, "Invalid token", api)
+	}
+	if err != nil {
+		s.logger.Errorf("%v: %v", api, err)
+		return nil, status.Error(codes.Internal, "An Internal error has occurred")
+	}
+
+	// The password has already been changed at this point so a failure to
+	// notify should not fail the request
+	_, err = s.mailSVC.SendResetPasswordConfirmation(ctx, &mailV1.EmailRequest{Email: u.Auth.Email})
+	if err != nil {
+		s.logger.Errorf("%v: mailSVC SendResetPasswordConfirmation: %v", api, err)
+	}
+
+	return &accountsV1.Empty{}, nil
+}
+
+// VerifyEmail is a gRPC handler that marks an account's email as verified
+// given a valid verification token
+func (s *Service) VerifyEmail(ctx context.Context, req *accountsV1.VerifyEmailRequest) (*accountsV1.Empty, error) {
+	api := "VerifyEmail: "
+
+	ip := common.GetMetadataValue(ctx, XForwardedFor)
+	token := strings.TrimSpace(req.GetToken())
+
+	errs := validator.Val(
+		s.validate,
+		validator.Field{
+			Param:          "token",
+			Message:        "Invalid verification token",
+			Value:          token,
+			Tag:            "required",
+			OmitParamValue: true,
+		},
+		validator.Field{
+			Param:   XForwardedFor,
+			Message: XForwardedFor + " header required",
+			Value:   ip,
+			Tag:     `required`,
+		},
+	)
+	// Validate
+	if len(errs) > 0 {
+		return nil, s.returnErrors(ctx, errs, codes.InvalidArgument, "Malformed request", api)
+	}
+	// Prepend IP for logging
+	api = fmt.Sprintf("[%v] %v", ip, api)
+
+	invalidToken := []validator.Error{
+		{
+			Param:   "token",
+			Message: "Verification token is invalid or has expired",
+		},
+	}
+
+	digest := hashToken(token)
+	u, err := s.accountsRepo.FindOne(nil, bson.M{"auth.verification_token": digest})
+	if err != nil {
+		s.logger.Errorf("%v: %v", api, err)
+		return nil, status.Error(codes.Internal, "An Internal error has occurred")
+	}
+	if u == nil || time.Now().After(u.Auth.VerificationTokenExpires) {
+		return nil, s.returnErrors(ctx, invalidToken, codes.InvalidArgument, "Invalid token", api)
+	}
+
+	_, err = s.accountsRepo.Update(
+		nil,
+		bson.M{
+			"_id":                     u.ID,
+			"auth.verification_token": digest,
+		},
+		bson.M{
+			"$set": bson.M{
+				"updated_at":                      time.Now(),
+				"auth.verified":                   true,
+				"auth.verified_date":              time.Now(),
+				"auth.verification_token":         "",
+				"auth.verification_token_expires": time.Time{},
+			},
+		},
+	)
+	if err == mongo.ErrNoDocuments {
+		return nil, s.returnErrors(ctx, invalidToken, codes.InvalidArgument, "Invalid token", api)
+	}
+	if err != nil {
+		s.logger.Errorf("%v: %v", api, err)
+		return nil, status.Error(codes.Internal, "An Internal error has occurred")
+	}
+
+	return &accountsV1.Empty{}, nil
+}
+
+// ResendVerification is a gRPC handler that issues a new verification token
+// and mails it. Requests are throttled per account, and throttled requests
+// respond as those for unknown or verified emails do.
+func (s *Service) ResendVerification(ctx context.Context, req *accountsV1.ResendVerificationRequest) (*accountsV1.Empty, error) {
+	api := "ResendVerification: "
+
+	email := strings.ToLower(strings.TrimSpace(req.GetEmail()))
+	ip := common.GetMetadataValue(ctx, XForwardedFor)
+	captchaResponse := common.GetMetadataValue(ctx, CaptchaResponse)
+
+	errs := validator.Val(
+		s.validate,
+		validator.Field{
+			Param:   "email",
+			Message: "Invalid email",
+			Value:   email,
+			Tag:     "required,email,emailMX,max=64",
+		},
+		validator.Field{
+			Param:   CaptchaResponse,
+			Message: CaptchaResponse + " header required",
+			Value:   captchaResponse,
+			Tag:     `required`,
+		},
+		validator.Field{
+			Param:   XForwardedFor,
+			Message: XForwardedFor + " header required",
+			Value:   ip,
+			Tag:     `required`,
+		},
+	)
+	// Validate
+	if len(errs) > 0 {
+		return nil, s.returnErrors(ctx, errs, codes.InvalidArgument, "Malformed request", api)
+	}
+	// Prepend IP for logging
+	api = fmt.Sprintf("[%v] %v", ip, api)
+
+	// Verify reCAPTCHA
+	if s.production {
+		rcpResp, err := recaptcha.Verify(captchaResponse, ip)
+		if err != nil || !rcpResp.Success {
+			s.logger.Errorf("%v: recaptcha verify: %v", api, err)
+			return nil, status.Error(codes.InvalidArgument, "Captcha Verification Failed")
+		}
+	}
+
+	u, err := s.findAccountByEmail(nil, email)
+	if err != nil {
+		s.logger.Errorf("%v: %v", api, err)
+		return nil, status.Error(codes.Internal, "An Internal error has occurred")
+	}
+	if u == nil || u.Auth.Verified {
+		s.logger.Warnf("%v: no unverified account for %v", api, email)
+		return &accountsV1.Empty{}, nil
+	}
+
+	token, err := generateToken(32)
+	if err != nil {
+		s.logger.Errorf("%v: %v", api, err)
+		return nil, status.Error(codes.Internal, "An Internal error has occurred")
+	}
+
+	// Only matches when the last email was sent before the resend interval
+	// so concurrent requests cannot bypass the throttle
+	_, err = s.accountsRepo.Update(
+		nil,
+		bson.M{
+			"_id":           u.ID,
+			"auth.verified": false,
+			// Accounts created before the time was recorded have no field
+			"$or": []interface{}{
+				bson.M{"auth.verification_sent": bson.M{"$exists": false}},
+				bson.M{"auth.verification_sent": bson.M{"$lt": time.Now().Add(-verificationResendInterval)}},
+			},
+		},
+		bson.M{
+			"$set": bson.M{
+				"updated_at":                      time.Now(),
+				"auth.verification_token":         hashToken(token),
+				"auth.verification_token_expires": time.Now().Add(verificationExpiry),
+				"auth.verification_sent":          time.Now(),
+			},
+		},
+	)
+	// Respond as for unknown accounts so the throttle does not reveal the
+	// account exists
+	if err == mongo.ErrNoDocuments {
+		s.logger.Warnf("%v: verification email was sent recently to %v", api, email)
+		return &accountsV1.Empty{}, nil
+	}
+	if err != nil {
+		s.logger.Errorf("%v: %v", api, err)
+		return nil, status.Error(codes.Internal, "An Internal error has occurred")
+	}
+
+	_, err = s.mailSVC.SendAccountVerification(ctx, &mailV1.AccountVerificationRequest{
+		Email:             u.Auth.Email,
+		VerificationToken: token,
+	})
+	if err != nil {
+		s.logger.Errorf("%v: mailSVC SendAccountVerification: %v", api, err)
+		return nil, status.Error(codes.Internal, "An Internal error has occurred")
+	}
+
+	return &accountsV1.Empty{}, nil
 }
@@ -2,16 +2,33 @@ package accounts
 
 import (
 	"context"
+	"crypto/sha1"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
 	"testing"
+	"time"
 
 	pb "github.com/isaiahwong/accounts-go/api/accounts/v1"
+	mailV1 "github.com/isaiahwong/accounts-go/api/mail/v1"
+	"github.com/isaiahwong/accounts-go/internal/breach"
 	"github.com/isaiahwong/accounts-go/internal/common/log"
+	"github.com/isaiahwong/accounts-go/internal/common/password"
+	"github.com/isaiahwong/accounts-go/internal/models"
+	"github.com/isaiahwong/accounts-go/internal/oauth"
 	"github.com/isaiahwong/accounts-go/tests/mocks"
 	"github.com/microcosm-cc/bluemonday"
 	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"golang.org/x/crypto/bcrypt"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 )
 
@@ -46,11 +63,77 @@ var invalidPasswords = []string{
 	"121314151617119****************&&", // 33 length too long
 }
 
+// testHashers hashes with the cheapest bcrypt cost which also matches
+// the legacy hashes the tests create
+var testHashers = password.NewRegistry(password.Bcrypt{Cost: bcrypt.MinCost})
+
 var validPasswords = []string{
 	"hello1234^",
 	"hello12345^",
 }
 
+// mailStub records mails instead of sending them
+type mailStub struct {
+	mailV1.MailServiceClient
+	sent   []string
+	links  []*mailV1.MagicLinkRequest
+	codes  []string
+	tokens []string
+}
+
+func (m *mailStub) SendResetPassword(ctx context.Context, in *mailV1.ResetPasswordRequest, opts ...grpc.CallOption) (*mailV1.EmailResponse, error) {
+	m.sent = append(m.sent, "SendResetPassword")
+	return &mailV1.EmailResponse{}, nil
+}
+
+func (m *mailStub) SendResetPasswordConfirmation(ctx context.Context, in *mailV1.EmailRequest, opts ...grpc.CallOption) (*mailV1.EmailResponse, error) {
+	m.sent = append(m.sent, "SendResetPasswordConfirmation")
+	return &mailV1.EmailResponse{}, nil
+}
+
+func (m *mailStub) SendAccountVerification(ctx context.Context, in *mailV1.AccountVerificationRequest, opts ...grpc.CallOption) (*mailV1.EmailResponse, error) {
+	m.sent = append(m.sent, "SendAccountVerification")
+	m.tokens = append(m.tokens, in.GetVerificationToken())
+	return &mailV1.EmailResponse{}, nil
+}
+
+func (m *mailStub) SendMagicLink(ctx context.Context, in *mailV1.MagicLinkRequest, opts ...grpc.CallOption) (*mailV1.EmailResponse, error) {
+	m.sent = append(m.sent, "SendMagicLink")
+	m.links = append(m.links, in)
+	return &mailV1.EmailResponse{}, nil
+}
+
+func (m *mailStub) SendLoginCode(ctx context.Context, in *mailV1.LoginCodeRequest, opts ...grpc.CallOption) (*mailV1.EmailResponse, error) {
+	m.sent = append(m.sent, "SendLoginCode")
+	m.codes = append(m.codes, in.GetCode())
+	return &mailV1.EmailResponse{}, nil
+}
+
+func (m *mailStub) SendEmailChangeVerification(ctx context.Context, in *mailV1.EmailChangeVerificationRequest, opts ...grpc.CallOption) (*mailV1.EmailResponse, error) {
+	m.sent = append(m.sent, "SendEmailChangeVerification")
+	m.tokens = append(m.tokens, in.GetToken())
+	return &mailV1.EmailResponse{}, nil
+}
+
+func (m *mailStub) SendEmailChangeNotification(ctx context.Context, in *mailV1.EmailChangeNotificationRequest, opts ...grpc.CallOption) (*mailV1.EmailResponse, error) {
+	m.sent = append(m.sent, "SendEmailChangeNotification")
+	m.tokens = append(m.tokens, in.GetRevertToken())
+	return &mailV1.EmailResponse{}, nil
+}
+
+func incomingContext(kv ...string) context.Context {
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs(kv...))
+}
+
+// setupHydra starts a fake Hydra admin server and returns a client for it.
+// The server should be closed by the caller.
+func setupHydra(handler http.HandlerFunc) (*oauth.Hydra, *httptest.Server) {
+	srv := httptest.NewServer(handler)
+	os.Setenv("HYDRA_ADMIN_URL", srv.URL)
+	defer os.Unsetenv("HYDRA_ADMIN_URL")
+	return oauth.NewHydraClient(), srv
+}
+
 func SetupRepo() *mocks.Repo {
 	r := new(mocks.Repo)
 	r.On("FindOne", nil, mock.Anything).Return(nil, nil)
@@ -66,10 +149,12 @@ func TestSignUp(t *testing.T) {
 	repo := SetupRepo()
 
 	svc := &Service{
-		production:   true,
-		logger:       logger,
-		policy:       bluemonday.StrictPolicy(),
-		accountsRepo: repo,
+		production:     true,
+		logger:         logger,
+		policy:         bluemonday.StrictPolicy(),
+		accountsRepo:   repo,
+		hashers:        testHashers,
+		passwordPolicy: password.DefaultPolicy(),
 	}
 	svc.initValidator()
 
@@ -116,3 +201,573 @@ func TestSignUp(t *testing.T) {
 		assert.Equal(t, codes.InvalidArgument, st.Code())
 	})
 }
+
+func TestEmailVerification(t *testing.T) {
+	acc := &models.Account{
+		ID:   primitive.NewObjectID(),
+		Auth: models.Auth{Email: "isaiah@example.com"},
+	}
+	// legacy marks the account as created before verification_sent was
+	// recorded
+	legacy := true
+
+	repo := new(mocks.Repo)
+	repo.On("FindOne", nil, mock.Anything).Return(func(_ context.Context, f interface{}, _ ...interface{}) *models.Account {
+		if t, ok := f.(bson.M)["auth.verification_token"]; ok && t != acc.Auth.VerificationToken {
+			return nil
+		}
+		return acc
+	}, nil)
+	repo.On("Update", nil, mock.Anything, mock.Anything).Return(1, func(_ context.Context, filter interface{}, update interface{}) error {
+		f := filter.(bson.M)
+		if t, ok := f["auth.verification_token"]; ok && t != acc.Auth.VerificationToken {
+			return mongo.ErrNoDocuments
+		}
+		// As in Mongo, comparisons never match a missing field
+		if _, ok := f["auth.verification_sent"]; ok && legacy {
+			return mongo.ErrNoDocuments
+		}
+		if or, ok := f["$or"]; ok {
+			lt := or.([]interface{})[1].(bson.M)["auth.verification_sent"].(bson.M)["$lt"].(time.Time)
+			if acc.Auth.Verified || !legacy && !acc.Auth.VerificationSent.Before(lt) {
+				return mongo.ErrNoDocuments
+			}
+		}
+		set := update.(bson.M)["$set"].(bson.M)
+		acc.Auth.VerificationToken = set["auth.verification_token"].(string)
+		acc.Auth.VerificationTokenExpires = set["auth.verification_token_expires"].(time.Time)
+		if v, ok := set["auth.verification_sent"]; ok {
+			acc.Auth.VerificationSent = v.(time.Time)
+			legacy = false
+		}
+		if v, ok := set["auth.verified"]; ok {
+			acc.Auth.Verified = v.(bool)
+		}
+		return nil
+	})
+
+	mail := &mailStub{}
+	svc := &Service{
+		logger:       logger,
+		accountsRepo: repo,
+		mailSVC:      mail,
+	}
+	svc.initValidator()
+	ctx := incomingContext(
+		XForwardedFor, "127.0.0.1",
+		CaptchaResponse, "captcha",
+	)
+	resend := func() error {
+		_, err := svc.ResendVerification(ctx, &pb.ResendVerificationRequest{Email: "isaiah@example.com"})
+		return err
+	}
+	verify := func(token string) error {
+		_, err := svc.VerifyEmail(ctx, &pb.VerifyEmailRequest{Token: token})
+		return err
+	}
+
+	t.Run("Resend throttle", func(t *testing.T) {
+		// Accounts which never recorded a send can resend
+		assert.NoError(t, resend())
+		assert.Len(t, mail.tokens, 1)
+
+		// Throttled requests look the same as those for unknown accounts
+		assert.NoError(t, resend())
+		assert.Len(t, mail.tokens, 1)
+
+		acc.Auth.VerificationSent = time.Now().Add(-verificationResendInterval - time.Second)
+		assert.NoError(t, resend())
+		assert.Len(t, mail.tokens, 2)
+
+		// The new token replaces the last
+		assert.Equal(t, codes.InvalidArgument, status.Code(verify(mail.tokens[0])))
+	})
+
+	t.Run("Expired", func(t *testing.T) {
+		expires := acc.Auth.VerificationTokenExpires
+		acc.Auth.VerificationTokenExpires = time.Now().Add(-time.Minute)
+		assert.Equal(t, codes.InvalidArgument, status.Code(verify(mail.tokens[1])))
+		assert.False(t, acc.Auth.Verified)
+		acc.Auth.VerificationTokenExpires = expires
+	})
+
+	t.Run("Verify", func(t *testing.T) {
+		assert.Equal(t, codes.InvalidArgument, status.Code(verify("")))
+		assert.NoError(t, verify(mail.tokens[1]))
+		assert.True(t, acc.Auth.Verified)
+
+		// Tokens are single use
+		assert.Equal(t, codes.InvalidArgument, status.Code(verify(mail.tokens[1])))
+	})
+
+	t.Run("Verified accounts are not mailed", func(t *testing.T) {
+		acc.Auth.VerificationSent = time.Time{}
+		assert.NoError(t, resend())
+		assert.Len(t, mail.tokens, 2)
+	})
+}
+
+func TestConfirmPasswordReset(t *testing.T) {
+	token := "reset-token"
+	ctx := incomingContext(XForwardedFor, "127.0.0.1")
+	newReq := func(token string) *pb.ConfirmPasswordResetRequest {
+		return &pb.ConfirmPasswordResetRequest{
+			Id:              "reset-id",
+			Token:           token,
+			Password:        validPasswords[0],
+			ConfirmPassword: validPasswords[0],
+		}
+	}
+	newSvc := func(acc *models.Account) (*Service, *mocks.Repo, *mailStub) {
+		repo := new(mocks.Repo)
+		repo.On("FindOne", nil, mock.Anything).Return(acc, nil)
+		repo.On("Update", nil, mock.Anything, mock.Anything).Return(1, nil)
+		mail := &mailStub{}
+		svc := &Service{
+			logger:         logger,
+			policy:         bluemonday.StrictPolicy(),
+			accountsRepo:   repo,
+			hashers:        testHashers,
+			passwordPolicy: password.DefaultPolicy(),
+			mailSVC:        mail,
+		}
+		svc.initValidator()
+		return svc, repo, mail
+	}
+	account := func(expires time.Time) *models.Account {
+		return &models.Account{
+			ID: primitive.NewObjectID(),
+			Auth: models.Auth{
+				Email:                "isaiah@example.com",
+				PasswordResetID:      "reset-id",
+				PasswordResetToken:   hashToken(token),
+				PasswordResetExpires: expires,
+			},
+		}
+	}
+
+	t.Run("Wrong token", func(t *testing.T) {
+		svc, repo, _ := newSvc(account(time.Now().Add(time.Hour)))
+		_, err := svc.ConfirmPasswordReset(ctx, newReq("guess"))
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		repo.AssertNotCalled(t, "Update", nil, mock.Anything, mock.Anything)
+	})
+
+	t.Run("Expired token", func(t *testing.T) {
+		svc, repo, _ := newSvc(account(time.Now().Add(-time.Minute)))
+		_, err := svc.ConfirmPasswordReset(ctx, newReq(token))
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		repo.AssertNotCalled(t, "Update", nil, mock.Anything, mock.Anything)
+	})
+
+	t.Run("Policy violations", func(t *testing.T) {
+		svc, repo, _ := newSvc(account(time.Now().Add(time.Hour)))
+		req := newReq(token)
+		req.Password = "isaiah1234"
+		req.ConfirmPassword = req.Password
+		_, err := svc.ConfirmPasswordReset(ctx, req)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		repo.AssertNotCalled(t, "Update", nil, mock.Anything, mock.Anything)
+
+		// Every violated rule is reported
+		errs := svc.checkPassword(req.Password, "isaiah@example.com")
+		var rules []interface{}
+		for _, e := range errs {
+			rules = append(rules, e.Value)
+		}
+		assert.Equal(t, []interface{}{password.RuleSymbol, password.RuleSubstring}, rules)
+	})
+
+	t.Run("Breached password", func(t *testing.T) {
+		svc, repo, _ := newSvc(account(time.Now().Add(time.Hour)))
+		corpus := breach.NewBloom(1, 0.001)
+		corpus.Add(sha1.Sum([]byte(validPasswords[0])))
+		svc.breached = corpus
+		_, err := svc.ConfirmPasswordReset(ctx, newReq(token))
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		repo.AssertNotCalled(t, "Update", nil, mock.Anything, mock.Anything)
+	})
+
+	t.Run("Valid token", func(t *testing.T) {
+		svc, repo, mail := newSvc(account(time.Now().Add(time.Hour)))
+		_, err := svc.ConfirmPasswordReset(ctx, newReq(token))
+		assert.NoError(t, err)
+		repo.AssertNumberOfCalls(t, "Update", 1)
+		assert.Equal(t, []string{"SendResetPasswordConfirmation"}, mail.sent)
+	})
+}
+
+func TestLoginCode(t *testing.T) {
+	acc := &models.Account{
+		ID:   primitive.NewObjectID(),
+		Auth: models.Auth{Email: "isaiah@example.com", Verified: true},
+	}
+
+	var accepted *oauth.HydraLoginAccept
+	hydra, srv := setupHydra(func(w http.ResponseWriter, r *http.Request) {
+		accepted = &oauth.HydraLoginAccept{}
+		json.NewDecoder(r.Body).Decode(accepted)
+		json.NewEncoder(w).Encode(oauth.HydraRedirect{RedirectTo: "https://example.com/callback"})
+	})
+	defer srv.Close()
+
+	// Apply login code updates to acc
+	repo := new(mocks.Repo)
+	repo.On("FindOne", nil, mock.MatchedBy(func(f bson.M) bool {
+		d, ok := f["login_code.login_challenge"]
+		return ok && d == acc.LoginCode.LoginChallenge
+	})).Return(acc, nil)
+	repo.On("FindOne", nil, mock.MatchedBy(func(f bson.M) bool {
+		_, ok := f["$or"]
+		return ok
+	})).Return(acc, nil)
+	repo.On("FindOne", nil, mock.Anything).Return(nil, nil)
+	repo.On("Update", nil, mock.Anything, mock.Anything).Return(1, func(_ context.Context, filter interface{}, update interface{}) error {
+		f := filter.(bson.M)
+		if _, ok := f["login_code.attempts"]; ok && acc.LoginCode.Attempts >= loginCodeAttempts {
+			return mongo.ErrNoDocuments
+		}
+		if d, ok := f["login_code.code"]; ok && d != acc.LoginCode.Code {
+			return mongo.ErrNoDocuments
+		}
+		if inc, ok := update.(bson.M)["$inc"].(bson.M); ok {
+			acc.LoginCode.Attempts += inc["login_code.attempts"].(int)
+		}
+		set := update.(bson.M)["$set"].(bson.M)
+		if v, ok := set["login_code.code"]; ok {
+			acc.LoginCode.Code = v.(string)
+			acc.LoginCode.LoginChallenge = set["login_code.login_challenge"].(string)
+			acc.LoginCode.Expires = set["login_code.expires"].(time.Time)
+			acc.LoginCode.Attempts = set["login_code.attempts"].(int)
+		}
+		if v, ok := set["login_code.locked_until"]; ok {
+			acc.LoginCode.LockedUntil = v.(time.Time)
+		}
+		return nil
+	})
+
+	mail := &mailStub{}
+	svc := &Service{
+		logger:       logger,
+		accountsRepo: repo,
+		oAuthClient:  hydra,
+		mailSVC:      mail,
+	}
+	svc.initValidator()
+
+	ctx := incomingContext(
+		XForwardedFor, "127.0.0.1",
+		CaptchaResponse, "captcha",
+		LoginChallenge, "challenge",
+	)
+	request := func(t *testing.T) string {
+		_, err := svc.RequestLoginCode(ctx, &pb.LoginCodeRequest{Email: "isaiah@example.com"})
+		assert.NoError(t, err)
+		return mail.codes[len(mail.codes)-1]
+	}
+	wrong := func(code string) string {
+		if code == "000000" {
+			return "000001"
+		}
+		return "000000"
+	}
+
+	t.Run("Verify", func(t *testing.T) {
+		code := request(t)
+		assert.Len(t, code, loginCodeDigits)
+		assert.NotEqual(t, hashToken(code), acc.LoginCode.Code)
+
+		resp, err := svc.VerifyLoginCode(ctx, &pb.VerifyLoginCodeRequest{Code: code})
+		assert.NoError(t, err)
+		assert.Equal(t, "https://example.com/callback", resp.GetRedirectTo())
+		assert.Equal(t, acc.ID.Hex(), accepted.Subject)
+		assert.Equal(t, []string{oauth.AMROTP}, accepted.Amr)
+
+		// Single use
+		_, err = svc.VerifyLoginCode(ctx, &pb.VerifyLoginCodeRequest{Code: code})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+
+	t.Run("Other challenge", func(t *testing.T) {
+		code := request(t)
+		other := incomingContext(
+			XForwardedFor, "127.0.0.1",
+			LoginChallenge, "other",
+		)
+		_, err := svc.VerifyLoginCode(other, &pb.VerifyLoginCodeRequest{Code: code})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+
+	t.Run("Expired", func(t *testing.T) {
+		code := request(t)
+		acc.LoginCode.Expires = time.Now().Add(-time.Minute)
+		_, err := svc.VerifyLoginCode(ctx, &pb.VerifyLoginCodeRequest{Code: code})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+
+	t.Run("Lockout", func(t *testing.T) {
+		code := request(t)
+		for i := 0; i < loginCodeAttempts; i++ {
+			_, err := svc.VerifyLoginCode(ctx, &pb.VerifyLoginCodeRequest{Code: wrong(code)})
+			assert.Equal(t, codes.PermissionDenied, status.Code(err))
+		}
+		assert.True(t, acc.LoginCode.LockedUntil.After(time.Now()))
+
+		// The right code is refused once locked
+		_, err := svc.VerifyLoginCode(ctx, &pb.VerifyLoginCodeRequest{Code: code})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+		// No new codes are issued while locked
+		sent := len(mail.codes)
+		_, err = svc.RequestLoginCode(ctx, &pb.LoginCodeRequest{Email: "isaiah@example.com"})
+		assert.NoError(t, err)
+		assert.Len(t, mail.codes, sent)
+	})
+}
+
+func TestAuthenticateRehash(t *testing.T) {
+	hash, _ := bcrypt.GenerateFromPassword([]byte(validPasswords[0]), bcrypt.MinCost)
+	acc := &models.Account{
+		ID:   primitive.NewObjectID(),
+		Auth: models.Auth{Email: "isaiah@example.com", Password: string(hash), Verified: true},
+	}
+	hydra, srv := setupHydra(func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(oauth.HydraRedirect{RedirectTo: "https://example.com/callback"})
+	})
+	defer srv.Close()
+
+	argon := password.Argon2id{Time: 1, Memory: 1024, Threads: 1, KeyLen: 32}
+	repo := new(mocks.Repo)
+	repo.On("FindOne", nil, mock.Anything).Return(acc, nil)
+	repo.On("Update", nil, mock.Anything, mock.Anything).Return(1, func(_ context.Context, filter interface{}, update interface{}) error {
+		set := update.(bson.M)["$set"].(bson.M)
+		if v, ok := set["auth.password"]; ok {
+			acc.Auth.Password = v.(string)
+			acc.Auth.PasswordHashMethod = set["auth.password_hash_method"].(string)
+		}
+		return nil
+	})
+	svc := &Service{
+		logger:       logger,
+		accountsRepo: repo,
+		hashers:      password.NewRegistry(argon),
+		oAuthClient:  hydra,
+	}
+	svc.initValidator()
+	ctx := incomingContext(
+		XForwardedFor, "127.0.0.1",
+		CaptchaResponse, "captcha",
+		LoginChallenge, "challenge",
+	)
+	req := &pb.AuthenticateRequest{Email: "isaiah@example.com", Password: validPasswords[0]}
+
+	_, err := svc.Authenticate(ctx, req)
+	assert.NoError(t, err)
+	assert.Equal(t, argon.Method(), acc.Auth.PasswordHashMethod)
+	assert.NotEqual(t, string(hash), acc.Auth.Password)
+
+	// The upgraded hash verifies
+	_, err = svc.Authenticate(ctx, req)
+	assert.NoError(t, err)
+}
+
+func TestIsAuthenticated(t *testing.T) {
+	acc := &models.Account{
+		ID: primitive.NewObjectID(),
+		Auth: models.Auth{
+			Email:     "isaiah@example.com",
+			FirstName: "Isaiah",
+			LastName:  "Wong",
+			Name:      "Isaiah Wong",
+			Verified:  true,
+		},
+	}
+	active := true
+	hydra, srv := setupHydra(func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(oauth.InstrospectResponse{
+			Active:   active,
+			Sub:      acc.ID.Hex(),
+			ClientID: "client",
+			Scope:    "openid offline",
+			Exp:      1000,
+		})
+	})
+	defer srv.Close()
+	repo := new(mocks.Repo)
+	repo.On("GetTimeout").Return(time.Second)
+	repo.On("FindOne", mock.Anything, mock.Anything).Return(acc, nil)
+	svc := &Service{
+		logger:       logger,
+		accountsRepo: repo,
+		oAuthClient:  hydra,
+	}
+	svc.initValidator()
+
+	t.Run("Missing token", func(t *testing.T) {
+		_, err := svc.IsAuthenticated(incomingContext(XForwardedFor, "127.0.0.1"), &pb.Empty{})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("Active token", func(t *testing.T) {
+		ctx := incomingContext(XForwardedFor, "127.0.0.1", Authorization, "Bearer token")
+		resp, err := svc.IsAuthenticated(ctx, &pb.Empty{})
+		assert.NoError(t, err)
+		assert.Equal(t, acc.ID.Hex(), resp.GetSubject())
+		assert.Equal(t, []string{"openid", "offline"}, resp.GetScopes())
+		assert.Equal(t, "client", resp.GetClientId())
+		assert.Equal(t, acc.Auth.Email, resp.GetEmail())
+		assert.True(t, resp.GetEmailVerified())
+	})
+
+	t.Run("Inactive token", func(t *testing.T) {
+		active = false
+		ctx := incomingContext(XForwardedFor, "127.0.0.1", Authorization, "Bearer token")
+		_, err := svc.IsAuthenticated(ctx, &pb.Empty{})
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	})
+}
+
+func TestBearerHeaders(t *testing.T) {
+	svc := &Service{logger: logger}
+	svc.initValidator()
+	ctx := incomingContext(XForwardedFor, "127.0.0.1")
+
+	// Every handler authenticated with a bearer token rejects a request
+	// without one the same way
+	for name, call := range map[string]func() error{
+		"GetMyProfile":      func() error { _, err := svc.GetMyProfile(ctx, &pb.Empty{}); return err },
+		"AddPhoneNumber":    func() error { _, err := svc.AddPhoneNumber(ctx, &pb.PhoneNumberRequest{}); return err },
+		"VerifyPhoneNumber": func() error { _, err := svc.VerifyPhoneNumber(ctx, &pb.SMSCodeRequest{}); return err },
+		"EnableSMSMFA":      func() error { _, err := svc.EnableSMSMFA(ctx, &pb.Empty{}); return err },
+		"DisableSMSMFA":     func() error { _, err := svc.DisableSMSMFA(ctx, &pb.Empty{}); return err },
+		"IsAuthenticated":   func() error { _, err := svc.IsAuthenticated(ctx, &pb.Empty{}); return err },
+		"EnrollTOTP":        func() error { _, err := svc.EnrollTOTP(ctx, &pb.Empty{}); return err },
+		"ConfirmTOTP":       func() error { _, err := svc.ConfirmTOTP(ctx, &pb.TOTPRequest{}); return err },
+		"DisableTOTP":       func() error { _, err := svc.DisableTOTP(ctx, &pb.TOTPRequest{}); return err },
+		"BeginWebAuthnRegistration": func() error {
+			_, err := svc.BeginWebAuthnRegistration(ctx, &pb.Empty{})
+			return err
+		},
+		"FinishWebAuthnRegistration": func() error {
+			_, err := svc.FinishWebAuthnRegistration(ctx, &pb.WebAuthnCredentialRequest{})
+			return err
+		},
+		"GenerateRecoveryCodes": func() error { _, err := svc.GenerateRecoveryCodes(ctx, &pb.Empty{}); return err },
+		"UnlockAccount":         func() error { _, err := svc.UnlockAccount(ctx, &pb.UnlockAccountRequest{}); return err },
+		"ImportAccounts":        func() error { _, err := svc.ImportAccounts(ctx, &pb.ImportAccountsRequest{}); return err },
+		"ChangePassword":        func() error { _, err := svc.ChangePassword(ctx, &pb.ChangePasswordRequest{}); return err },
+		"RequestEmailChange": func() error {
+			_, err := svc.RequestEmailChange(ctx, &pb.EmailChangeRequest{})
+			return err
+		},
+		"UpdateMyProfile": func() error {
+			_, err := svc.UpdateMyProfile(ctx, &pb.UpdateProfileRequest{})
+			return err
+		},
+	} {
+		assert.Equal(t, codes.InvalidArgument, status.Code(call()), name)
+	}
+}
+
+func TestLogout(t *testing.T) {
+	acc := &models.Account{ID: primitive.NewObjectID()}
+	subject := acc.ID.Hex()
+	var rejected *oauth.HydraError
+	hydra, srv := setupHydra(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("logout_challenge") != "challenge" {
+			w.WriteHeader(http.StatusNotFound)
+			json.NewEncoder(w).Encode(oauth.HydraError{ErrorName: "Not Found", StatusCode: http.StatusNotFound})
+			return
+		}
+		switch r.URL.Path {
+		case "/oauth2/auth/requests/logout":
+			json.NewEncoder(w).Encode(map[string]string{
+				"request_url": "https://example.com/logout",
+				"sid":         "sid",
+				"subject":     subject,
+			})
+		case "/oauth2/auth/requests/logout/accept":
+			json.NewEncoder(w).Encode(oauth.HydraRedirect{RedirectTo: "https://example.com/done"})
+		case "/oauth2/auth/requests/logout/reject":
+			rejected = &oauth.HydraError{}
+			json.NewDecoder(r.Body).Decode(rejected)
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+	defer srv.Close()
+
+	var updates []bson.M
+	repo := new(mocks.Repo)
+	repo.On("GetTimeout").Return(time.Second)
+	repo.On("Update", nil, mock.Anything, mock.Anything).Return(1, func(_ context.Context, filter interface{}, update interface{}) error {
+		assert.Equal(t, bson.M{"_id": acc.ID}, filter)
+		updates = append(updates, update.(bson.M))
+		return nil
+	})
+	svc := &Service{
+		logger:       logger,
+		accountsRepo: repo,
+		oAuthClient:  hydra,
+	}
+	svc.initValidator()
+	ctx := incomingContext(XForwardedFor, "127.0.0.1", LogoutChallenge, "challenge")
+
+	t.Run("Malformed", func(t *testing.T) {
+		noChallenge := incomingContext(XForwardedFor, "127.0.0.1")
+		_, err := svc.LogoutWithChallenge(noChallenge, &pb.Empty{})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		_, err = svc.AcceptLogout(noChallenge, &pb.Empty{})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		_, err = svc.RejectLogout(noChallenge, &pb.Empty{})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("Unknown challenge", func(t *testing.T) {
+		unknown := incomingContext(XForwardedFor, "127.0.0.1", LogoutChallenge, "unknown")
+		_, err := svc.LogoutWithChallenge(unknown, &pb.Empty{})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+		_, err = svc.AcceptLogout(unknown, &pb.Empty{})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+		assert.Empty(t, updates)
+	})
+
+	t.Run("Challenge", func(t *testing.T) {
+		resp, err := svc.LogoutWithChallenge(ctx, &pb.Empty{})
+		assert.NoError(t, err)
+		assert.Equal(t, "challenge", resp.GetChallenge())
+		assert.Equal(t, "https://example.com/logout", resp.GetRequestUrl())
+		assert.Equal(t, "sid", resp.GetSessionId())
+		assert.Equal(t, subject, resp.GetSubject())
+	})
+
+	t.Run("Accept", func(t *testing.T) {
+		resp, err := svc.AcceptLogout(ctx, &pb.Empty{})
+		assert.NoError(t, err)
+		assert.Equal(t, "https://example.com/done", resp.GetRedirectTo())
+		assert.Len(t, updates, 1)
+		session := updates[0]["$push"].(bson.M)["sessions"].(models.Session)
+		assert.Equal(t, "127.0.0.1", session.IP)
+		assert.Equal(t, "sid", session.SID)
+		assert.False(t, session.LoggedOut.IsZero())
+		assert.Contains(t, updates[0]["$set"], "logged_out")
+	})
+
+	t.Run("Accept without session", func(t *testing.T) {
+		updates, subject = nil, ""
+		resp, err := svc.AcceptLogout(ctx, &pb.Empty{})
+		assert.NoError(t, err)
+		assert.Equal(t, "https://example.com/done", resp.GetRedirectTo())
+		assert.Empty(t, updates)
+	})
+
+	t.Run("Reject", func(t *testing.T) {
+		_, err := svc.RejectLogout(ctx, &pb.Empty{})
+		assert.NoError(t, err)
+		assert.Equal(t, &oauth.HydraError{
+			ErrorName:        "access_denied",
+			ErrorDescription: "The resource owner denied the logout request",
+		}, rejected)
+	})
+}
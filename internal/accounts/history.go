@@ -0,0 +1,77 @@
+package accounts
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/isaiahwong/accounts-go/internal/common/validator"
+	"github.com/isaiahwong/accounts-go/internal/models"
+	"google.golang.org/grpc/codes"
+)
+
+// recentPasswords returns the account's current password followed by the
+// previous ones still covered by the history depth
+func (s *Service) recentPasswords(u *models.Account) []models.PreviousPassword {
+	var recent []models.PreviousPassword
+	if u.Auth.Password != "" {
+		recent = append(recent, models.PreviousPassword{
+			Hash:   u.Auth.Password,
+			Method: u.Auth.PasswordHashMethod,
+		})
+	}
+	recent = append(recent, u.Auth.PasswordHistory...)
+	if len(recent) > s.passwordHistory {
+		recent = recent[:s.passwordHistory]
+	}
+	return recent
+}
+
+// checkPasswordReuse returns an InvalidArgument error if password is one of
+// the account's recent passwords. Hashes which cannot be verified, such as
+// those of a hasher no longer registered, are skipped.
+func (s *Service) checkPasswordReuse(ctx context.Context, u *models.Account, password string, prefix string) error {
+	for _, p := range s.recentPasswords(u) {
+		ok, _, err := s.hashers.Verify(password, p.Hash, p.Method)
+		if err != nil {
+			s.logger.Warnf("%v: password history: %v", prefix, err)
+			continue
+		}
+		if !ok {
+			continue
+		}
+		msg := fmt.Sprintf("Password must differ from your last %d passwords", s.passwordHistory)
+		if s.passwordHistory == 1 {
+			msg = "Password must differ from your current password"
+		}
+		return s.returnErrors(ctx, []validator.Error{
+			{
+				Param:   "password",
+				Message: msg,
+			},
+		}, codes.InvalidArgument, "Password was used recently", prefix)
+	}
+	return nil
+}
+
+// nextPasswordHistory returns the history to store once the account's current
+// password is replaced. Hashes beyond the depth are dropped.
+func (s *Service) nextPasswordHistory(u *models.Account) []models.PreviousPassword {
+	history := []models.PreviousPassword{}
+	if s.passwordHistory <= 1 {
+		return history
+	}
+	if u.Auth.Password != "" {
+		history = append(history, models.PreviousPassword{
+			Hash:     u.Auth.Password,
+			Method:   u.Auth.PasswordHashMethod,
+			Replaced: time.Now(),
+		})
+	}
+	history = append(history, u.Auth.PasswordHistory...)
+	// The current password counts towards the depth
+	if len(history) > s.passwordHistory-1 {
+		history = history[:s.passwordHistory-1]
+	}
+	return history
+}
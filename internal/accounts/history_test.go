@@ -0,0 +1,104 @@
+package accounts
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	pb "github.com/isaiahwong/accounts-go/api/accounts/v1"
+	"github.com/isaiahwong/accounts-go/internal/common/password"
+	"github.com/isaiahwong/accounts-go/internal/models"
+	"github.com/isaiahwong/accounts-go/tests/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestPasswordHistory(t *testing.T) {
+	hash := func(pw string) models.PreviousPassword {
+		h, method, err := testHashers.Hash(pw)
+		assert.NoError(t, err)
+		return models.PreviousPassword{Hash: h, Method: method}
+	}
+	current := hash("current1^")
+	acc := &models.Account{
+		ID: primitive.NewObjectID(),
+		Auth: models.Auth{
+			Email:              "isaiah@example.com",
+			Password:           current.Hash,
+			PasswordHashMethod: current.Method,
+			PasswordHistory:    []models.PreviousPassword{hash("previous1^"), hash("previous2^"), hash("previous3^")},
+		},
+	}
+	svc := &Service{
+		logger:          logger,
+		hashers:         testHashers,
+		passwordHistory: 3,
+	}
+	ctx := incomingContext()
+
+	for _, pw := range []string{"current1^", "previous1^", "previous2^"} {
+		assert.Equal(t, codes.InvalidArgument, status.Code(svc.checkPasswordReuse(ctx, acc, pw, "")), pw)
+	}
+	// Beyond the depth
+	assert.NoError(t, svc.checkPasswordReuse(ctx, acc, "previous3^", ""))
+	assert.NoError(t, svc.checkPasswordReuse(ctx, acc, "another1^", ""))
+
+	history := svc.nextPasswordHistory(acc)
+	assert.Len(t, history, 2)
+	assert.Equal(t, current.Hash, history[0].Hash)
+	assert.False(t, history[0].Replaced.IsZero())
+	assert.Equal(t, acc.Auth.PasswordHistory[0].Hash, history[1].Hash)
+
+	t.Run("Disabled", func(t *testing.T) {
+		svc := &Service{logger: logger, hashers: testHashers}
+		assert.NoError(t, svc.checkPasswordReuse(ctx, acc, "current1^", ""))
+		assert.Empty(t, svc.nextPasswordHistory(acc))
+	})
+
+	t.Run("Reset", func(t *testing.T) {
+		acc := *acc
+		acc.Auth.PasswordResetID = "reset-id"
+		acc.Auth.PasswordResetToken = hashToken("reset-token")
+		acc.Auth.PasswordResetExpires = time.Now().Add(time.Hour)
+
+		var set bson.M
+		repo := new(mocks.Repo)
+		repo.On("FindOne", nil, mock.Anything).Return(&acc, nil)
+		repo.On("Update", nil, mock.Anything, mock.Anything).Return(1, func(_ context.Context, _ interface{}, update interface{}) error {
+			set = update.(bson.M)["$set"].(bson.M)
+			return nil
+		})
+		svc := &Service{
+			logger:          logger,
+			accountsRepo:    repo,
+			hashers:         testHashers,
+			passwordPolicy:  password.DefaultPolicy(),
+			passwordHistory: 3,
+			mailSVC:         &mailStub{},
+		}
+		svc.initValidator()
+		ctx := incomingContext(XForwardedFor, "127.0.0.1")
+		req := func(pw string) *pb.ConfirmPasswordResetRequest {
+			return &pb.ConfirmPasswordResetRequest{
+				Id:              "reset-id",
+				Token:           "reset-token",
+				Password:        pw,
+				ConfirmPassword: pw,
+			}
+		}
+
+		_, err := svc.ConfirmPasswordReset(ctx, req("previous1^"))
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		repo.AssertNotCalled(t, "Update", nil, mock.Anything, mock.Anything)
+
+		_, err = svc.ConfirmPasswordReset(ctx, req("another1^"))
+		assert.NoError(t, err)
+		history := set["auth.password_history"].([]models.PreviousPassword)
+		assert.Len(t, history, 2)
+		assert.Equal(t, current.Hash, history[0].Hash)
+	})
+}
@@ -0,0 +1,159 @@
+package accounts
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	accountsV1 "github.com/isaiahwong/accounts-go/api/accounts/v1"
+	"github.com/isaiahwong/accounts-go/internal/common/password"
+	"github.com/isaiahwong/accounts-go/internal/common/validator"
+	"github.com/isaiahwong/accounts-go/internal/models"
+)
+
+// maxImportBatch is the most accounts imported per request
+const maxImportBatch = 1000
+
+// ImportAccounts is a gRPC handler that creates accounts migrated from other
+// systems keeping their password hashes. Hashes must be of a registered
+// method with parameters within its bounds, and are upgraded on the
+// account's next login. Accounts which fail to import are reported without
+// failing the rest. The bearer token must be granted the admin scope.
+func (s *Service) ImportAccounts(ctx context.Context, req *accountsV1.ImportAccountsRequest) (*accountsV1.ImportAccountsResponse, error) {
+	api := "ImportAccounts: "
+
+	accounts := req.GetAccounts()
+
+	errs := validator.Val(
+		s.validate,
+		validator.Field{
+			Param:          "accounts",
+			Message:        fmt.Sprintf("Between 1 and %d accounts required", maxImportBatch),
+			Value:          len(accounts),
+			Tag:            fmt.Sprintf("min=1,max=%d", maxImportBatch),
+			OmitParamValue: true,
+		},
+	)
+	token, err := s.validateBearer(ctx, &api, errs...)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.requireScope(ctx, token, s.adminScope, api); err != nil {
+		return nil, err
+	}
+
+	resp := &accountsV1.ImportAccountsResponse{}
+	seen := map[string]bool{}
+	for i, a := range accounts {
+		email := strings.ToLower(strings.TrimSpace(a.GetEmail()))
+		fail := func(msg string) {
+			resp.Errors = append(resp.Errors, &accountsV1.ImportAccountError{
+				Index:   int32(i),
+				Email:   email,
+				Message: msg,
+			})
+		}
+
+		u, msg := s.importedAccount(a, email)
+		if msg != "" {
+			fail(msg)
+			continue
+		}
+		if seen[email] {
+			fail("Email is duplicated in the request")
+			continue
+		}
+		seen[email] = true
+
+		existing, err := s.findAccountByEmail(nil, email)
+		if err != nil {
+			s.logger.Errorf("%v: %v", api, err)
+			fail("An Internal error has occurred")
+			continue
+		}
+		if existing != nil {
+			fail("Email is already in used")
+			continue
+		}
+		if _, err := s.accountsRepo.Save(nil, u); err != nil {
+			s.logger.Errorf("%v: account saving: %v", api, err)
+			fail("An Internal error has occurred")
+			continue
+		}
+		resp.Imported++
+	}
+	s.logger.Infof("%v: imported %d of %d accounts", api, resp.Imported, len(accounts))
+
+	return resp, nil
+}
+
+// importedAccount validates an imported account and builds its model. A
+// message describing the problem is returned if it is invalid.
+func (s *Service) importedAccount(a *accountsV1.ImportedAccount, email string) (*models.Account, string) {
+	firstname := s.policy.Sanitize(strings.TrimSpace(a.GetFirstName()))
+	lastname := s.policy.Sanitize(strings.TrimSpace(a.GetLastName()))
+	hash := strings.TrimSpace(a.GetPasswordHash())
+	method := strings.TrimSpace(a.GetPasswordHashMethod())
+
+	errs := validator.Val(
+		s.validate,
+		validator.Field{
+			Param:   "email",
+			Message: "Invalid email",
+			Value:   email,
+			Tag:     "required,email,max=64",
+		},
+		validator.Field{
+			Param:   "first_name",
+			Message: "Invalid first name",
+			Value:   firstname,
+			Tag:     "max=64",
+		},
+		validator.Field{
+			Param:   "last_name",
+			Message: "Invalid last name",
+			Value:   lastname,
+			Tag:     "max=64",
+		},
+		validator.Field{
+			Param:   "password_hash",
+			Message: "Password hash required",
+			Value:   hash,
+			Tag:     "required",
+		},
+	)
+	if len(errs) > 0 {
+		return nil, errs[0].Message
+	}
+	// Parameters are bounded as verifying the hash on every login attempt
+	// must not exhaust the server
+	switch s.hashers.Check(hash, method) {
+	case nil:
+	case password.ErrUnknownMethod:
+		return nil, "Unsupported password hash method"
+	case password.ErrUnsafeParams:
+		return nil, "Password hash parameters are out of bounds"
+	default:
+		return nil, "Invalid password hash"
+	}
+
+	u := &models.Account{
+		Auth: models.Auth{
+			Email:              email,
+			Password:           hash,
+			PasswordHashMethod: method,
+			PasswordModified:   time.Now(),
+			FirstName:          firstname,
+			LastName:           lastname,
+			Name:               strings.TrimSpace(firstname + " " + lastname),
+			Verified:           a.GetEmailVerified(),
+		},
+		Object: "account",
+	}
+	if u.Auth.Verified {
+		u.Auth.VerifiedDate = time.Now()
+	}
+	return u, ""
+}
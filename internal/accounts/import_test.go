@@ -0,0 +1,133 @@
+package accounts
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	pb "github.com/isaiahwong/accounts-go/api/accounts/v1"
+	"github.com/isaiahwong/accounts-go/internal/common/password"
+	"github.com/isaiahwong/accounts-go/internal/models"
+	"github.com/isaiahwong/accounts-go/internal/oauth"
+	"github.com/isaiahwong/accounts-go/tests/mocks"
+	"github.com/microcosm-cc/bluemonday"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"golang.org/x/crypto/bcrypt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestImportAccounts(t *testing.T) {
+	hydra, srv := setupHydra(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/oauth2/introspect" {
+			json.NewEncoder(w).Encode(oauth.InstrospectResponse{Active: true, Sub: "admin"})
+			return
+		}
+		json.NewEncoder(w).Encode(oauth.HydraRedirect{RedirectTo: "https://example.com/callback"})
+	})
+	defer srv.Close()
+
+	existing := &models.Account{
+		ID:   primitive.NewObjectID(),
+		Auth: models.Auth{Email: "taken@example.com"},
+	}
+	var saved []*models.Account
+	repo := new(mocks.Repo)
+	// findAccountByEmail filters on {"$or": [{"auth.email": email}]}
+	email := func(f bson.M) string {
+		or, _ := f["$or"].([]interface{})
+		if len(or) == 0 {
+			return ""
+		}
+		e, _ := or[0].(bson.M)["auth.email"].(string)
+		return e
+	}
+	repo.On("FindOne", nil, mock.MatchedBy(func(f bson.M) bool {
+		return email(f) == existing.Auth.Email
+	})).Return(existing, nil)
+	repo.On("FindOne", nil, mock.MatchedBy(func(f bson.M) bool {
+		return len(saved) > 0 && email(f) == saved[0].Auth.Email
+	})).Return(func(context.Context, interface{}, ...interface{}) *models.Account {
+		return saved[0]
+	}, nil)
+	repo.On("FindOne", nil, mock.Anything).Return(nil, nil)
+	repo.On("Save", nil, mock.Anything).Return(func(_ context.Context, u *models.Account) string {
+		u.ID = primitive.NewObjectID()
+		saved = append(saved, u)
+		return u.ID.Hex()
+	}, nil)
+	repo.On("Update", nil, mock.Anything, mock.Anything).Return(1, func(_ context.Context, filter interface{}, update interface{}) error {
+		set := update.(bson.M)["$set"].(bson.M)
+		if v, ok := set["auth.password"]; ok {
+			saved[0].Auth.Password = v.(string)
+			saved[0].Auth.PasswordHashMethod = set["auth.password_hash_method"].(string)
+		}
+		return nil
+	})
+
+	svc := &Service{
+		logger:       logger,
+		policy:       bluemonday.StrictPolicy(),
+		accountsRepo: repo,
+		hashers:      password.NewRegistry(password.Bcrypt{Cost: bcrypt.MinCost}, password.SHACrypt{}),
+		oAuthClient:  hydra,
+		adminScope:   "accounts.admin",
+	}
+	svc.initValidator()
+
+	shaCrypt := "$5$saltstring$5B8vYYiY.CVt1RlTTf8KbXBH3hsxY/GNooZaBBGWEc5"
+	ctx := incomingContext(XForwardedFor, "127.0.0.1", Authorization, "Bearer token")
+	resp, err := svc.ImportAccounts(ctx, &pb.ImportAccountsRequest{
+		Accounts: []*pb.ImportedAccount{
+			{
+				Email:              "Isaiah@example.com",
+				FirstName:          "Isaiah",
+				LastName:           "Wong",
+				EmailVerified:      true,
+				PasswordHash:       shaCrypt,
+				PasswordHashMethod: "sha-crypt",
+			},
+			{Email: "isaiah@example.com", PasswordHash: shaCrypt, PasswordHashMethod: "sha-crypt"},
+			{Email: "md5@example.com", PasswordHash: "hash", PasswordHashMethod: "md5"},
+			{Email: "taken@example.com", PasswordHash: shaCrypt, PasswordHashMethod: "sha-crypt"},
+			{Email: "invalid", PasswordHash: shaCrypt, PasswordHashMethod: "sha-crypt"},
+			{Email: "costly@example.com", PasswordHash: "$5$rounds=999999999$salt$digest", PasswordHashMethod: "sha-crypt"},
+			{Email: "bcrypt@example.com", PasswordHash: "hash"},
+		},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(1), resp.GetImported())
+	assert.Len(t, resp.GetErrors(), 6)
+	for i, e := range resp.GetErrors() {
+		assert.Equal(t, int32(i+1), e.GetIndex())
+	}
+	assert.Equal(t, "Password hash parameters are out of bounds", resp.GetErrors()[4].GetMessage())
+	assert.Equal(t, "Invalid password hash", resp.GetErrors()[5].GetMessage())
+	assert.Len(t, saved, 1)
+	assert.Equal(t, "isaiah@example.com", saved[0].Auth.Email)
+	assert.Equal(t, "Isaiah Wong", saved[0].Auth.Name)
+	assert.True(t, saved[0].Auth.Verified)
+
+	t.Run("Upgraded on login", func(t *testing.T) {
+		ctx := incomingContext(
+			XForwardedFor, "127.0.0.1",
+			CaptchaResponse, "captcha",
+			LoginChallenge, "challenge",
+		)
+		_, err := svc.Authenticate(ctx, &pb.AuthenticateRequest{Email: "isaiah@example.com", Password: "Hello world!"})
+		assert.NoError(t, err)
+		assert.Equal(t, "bcrypt$cost=4", saved[0].Auth.PasswordHashMethod)
+		assert.NoError(t, bcrypt.CompareHashAndPassword([]byte(saved[0].Auth.Password), []byte("Hello world!")))
+	})
+
+	t.Run("Missing token", func(t *testing.T) {
+		_, err := svc.ImportAccounts(incomingContext(XForwardedFor, "127.0.0.1"), &pb.ImportAccountsRequest{
+			Accounts: []*pb.ImportedAccount{{Email: "a@example.com"}},
+		})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
@@ -0,0 +1,175 @@
+package accounts
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	accountsV1 "github.com/isaiahwong/accounts-go/api/accounts/v1"
+	"github.com/isaiahwong/accounts-go/internal/common/validator"
+	"github.com/isaiahwong/accounts-go/internal/models"
+	repo "github.com/isaiahwong/accounts-go/internal/store/repo/accounts"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// lockoutPolicy defines when failed password attempts lock an account. A
+// zero threshold disables locking.
+type lockoutPolicy struct {
+	// threshold is the number of consecutive failures which lock the account
+	threshold int
+	// duration is how long the first lock lasts. Each further lock before a
+	// successful login doubles it up to maxDuration.
+	duration    time.Duration
+	maxDuration time.Duration
+}
+
+// lockDuration returns how long the account is locked for given the number
+// of locks applied before
+func (p lockoutPolicy) lockDuration(locks int) time.Duration {
+	d := p.duration
+	for i := 0; i < locks && d < p.maxDuration; i++ {
+		d *= 2
+	}
+	if p.maxDuration > 0 && d > p.maxDuration {
+		d = p.maxDuration
+	}
+	return d
+}
+
+// lockedError returns the PermissionDenied error for a locked account. The
+// time the lock lifts is set in the errors-bin trailer.
+func (s *Service) lockedError(ctx context.Context, until time.Time, prefix string) error {
+	return s.returnErrors(ctx, []validator.Error{
+		{
+			Param:   "email",
+			Message: "Account is temporarily locked due to too many failed attempts",
+			Value:   until.UTC().Format(time.RFC3339),
+		},
+	}, codes.PermissionDenied, "Account is temporarily locked", prefix)
+}
+
+// recordFailedLogin counts a failed password attempt against the account,
+// locking it once the policy's threshold is reached. It reports whether the
+// account was locked. The count is incremented and compared in the store so
+// concurrent failures cannot each read a count below the threshold.
+func (s *Service) recordFailedLogin(u *models.Account, prefix string) (bool, time.Time, error) {
+	if s.lockout.threshold <= 0 {
+		return false, time.Time{}, nil
+	}
+
+	_, err := s.accountsRepo.Update(
+		nil,
+		bson.M{"_id": u.ID},
+		bson.M{
+			"$set": bson.M{"lockout.last_failed": time.Now()},
+			"$inc": bson.M{"lockout.failed_attempts": 1},
+		},
+	)
+	if err != nil {
+		return false, time.Time{}, err
+	}
+
+	// Only the failure which finds the threshold reached locks the account
+	// as the lock resets the count
+	until := time.Now().Add(s.lockout.lockDuration(u.Lockout.Locks))
+	_, err = s.accountsRepo.Update(
+		nil,
+		bson.M{
+			"_id":                     u.ID,
+			"lockout.failed_attempts": bson.M{"$gte": s.lockout.threshold},
+		},
+		bson.M{
+			"$set": bson.M{
+				"updated_at":              time.Now(),
+				"lockout.failed_attempts": 0,
+				"lockout.locked_until":    until,
+			},
+			"$inc": bson.M{"lockout.locks": 1},
+		},
+	)
+	if err == mongo.ErrNoDocuments {
+		return false, time.Time{}, nil
+	}
+	if err != nil {
+		return false, time.Time{}, err
+	}
+	s.logger.Warnf("%v: account %v locked until %v", prefix, u.ID.Hex(), until)
+	return true, until, nil
+}
+
+// resetFailedLogins clears the account's failed attempts after a successful
+// login
+func (s *Service) resetFailedLogins(u *models.Account) error {
+	if u.Lockout == (models.Lockout{}) {
+		return nil
+	}
+	_, err := s.accountsRepo.Update(
+		nil,
+		bson.M{"_id": u.ID},
+		bson.M{
+			"$set": bson.M{
+				"updated_at": time.Now(),
+				"lockout":    models.Lockout{},
+			},
+		},
+	)
+	return err
+}
+
+// UnlockAccount is a gRPC handler that lifts the lock and clears failed
+// attempts for an account. The bearer token must be granted the admin scope.
+func (s *Service) UnlockAccount(ctx context.Context, req *accountsV1.UnlockAccountRequest) (*accountsV1.Empty, error) {
+	api := "UnlockAccount: "
+
+	id := strings.TrimSpace(req.GetId())
+
+	errs := validator.Val(
+		s.validate,
+		validator.Field{
+			Param:   "id",
+			Message: "Invalid account id",
+			Value:   id,
+			Tag:     "required",
+		},
+	)
+	token, err := s.validateBearer(ctx, &api, errs...)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.requireScope(ctx, token, s.adminScope, api); err != nil {
+		return nil, err
+	}
+
+	fctx, cancel := context.WithTimeout(ctx, s.accountsRepo.GetTimeout())
+	defer cancel()
+	u, err := s.findAccountByID(fctx, id)
+	if err != nil {
+		s.logger.Errorf("%v: %v", api, err)
+		return nil, status.Error(codes.Internal, "An Internal error has occurred")
+	}
+	if u == nil {
+		return nil, status.Error(codes.NotFound, "Account not found")
+	}
+
+	_, err = s.accountsRepo.Update(
+		nil,
+		bson.M{"_id": u.ID},
+		bson.M{
+			"$set": bson.M{
+				"updated_at": time.Now(),
+				"lockout":    models.Lockout{},
+			},
+		},
+	)
+	if err != nil && err != repo.ErrUpdateDocuments {
+		s.logger.Errorf("%v: %v", api, err)
+		return nil, status.Error(codes.Internal, "An Internal error has occurred")
+	}
+	s.logger.Infof("%v: account %v unlocked", api, u.ID.Hex())
+
+	return &accountsV1.Empty{}, nil
+}
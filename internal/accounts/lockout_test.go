@@ -0,0 +1,181 @@
+package accounts
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	pb "github.com/isaiahwong/accounts-go/api/accounts/v1"
+	"github.com/isaiahwong/accounts-go/internal/models"
+	"github.com/isaiahwong/accounts-go/internal/oauth"
+	"github.com/isaiahwong/accounts-go/tests/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"golang.org/x/crypto/bcrypt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestLockDuration(t *testing.T) {
+	p := lockoutPolicy{threshold: 5, duration: 15 * time.Minute, maxDuration: time.Hour}
+	assert.Equal(t, 15*time.Minute, p.lockDuration(0))
+	assert.Equal(t, 30*time.Minute, p.lockDuration(1))
+	assert.Equal(t, time.Hour, p.lockDuration(2))
+	assert.Equal(t, time.Hour, p.lockDuration(10))
+}
+
+func TestLockout(t *testing.T) {
+	hash, _ := bcrypt.GenerateFromPassword([]byte("hello1234^"), bcrypt.MinCost)
+	acc := &models.Account{
+		ID:   primitive.NewObjectID(),
+		Auth: models.Auth{Email: "isaiah@example.com", Password: string(hash), Verified: true},
+	}
+
+	scoped := true
+	hydra, srv := setupHydra(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/oauth2/introspect" {
+			r.ParseForm()
+			json.NewEncoder(w).Encode(oauth.InstrospectResponse{
+				Active: scoped && r.PostForm.Get("scope") == "accounts.admin",
+				Sub:    "admin",
+			})
+			return
+		}
+		json.NewEncoder(w).Encode(oauth.HydraRedirect{RedirectTo: "https://example.com/callback"})
+	})
+	defer srv.Close()
+
+	// Apply lockout updates to acc
+	repo := new(mocks.Repo)
+	repo.On("GetTimeout").Return(time.Second)
+	repo.On("FindOne", mock.Anything, mock.Anything).Return(acc, nil)
+	repo.On("Update", nil, mock.Anything, mock.Anything).Return(1, func(_ context.Context, filter interface{}, update interface{}) error {
+		if f, ok := filter.(bson.M)["lockout.failed_attempts"]; ok && acc.Lockout.FailedAttempts < f.(bson.M)["$gte"].(int) {
+			return mongo.ErrNoDocuments
+		}
+		if inc, ok := update.(bson.M)["$inc"].(bson.M); ok {
+			if v, ok := inc["lockout.failed_attempts"]; ok {
+				acc.Lockout.FailedAttempts += v.(int)
+			}
+			if v, ok := inc["lockout.locks"]; ok {
+				acc.Lockout.Locks += v.(int)
+			}
+		}
+		set := update.(bson.M)["$set"].(bson.M)
+		if v, ok := set["lockout"]; ok {
+			acc.Lockout = v.(models.Lockout)
+		}
+		if v, ok := set["lockout.failed_attempts"]; ok {
+			acc.Lockout.FailedAttempts = v.(int)
+		}
+		if v, ok := set["lockout.locked_until"]; ok {
+			acc.Lockout.LockedUntil = v.(time.Time)
+		}
+		return nil
+	})
+
+	svc := &Service{
+		logger:       logger,
+		accountsRepo: repo,
+		hashers:      testHashers,
+		oAuthClient:  hydra,
+		adminScope:   "accounts.admin",
+		lockout:      lockoutPolicy{threshold: 3, duration: time.Minute, maxDuration: time.Hour},
+	}
+	svc.initValidator()
+
+	ctx := incomingContext(
+		XForwardedFor, "127.0.0.1",
+		CaptchaResponse, "captcha",
+		LoginChallenge, "challenge",
+	)
+	authenticate := func(password string) error {
+		_, err := svc.Authenticate(ctx, &pb.AuthenticateRequest{Email: "isaiah@example.com", Password: password})
+		return err
+	}
+
+	t.Run("No password", func(t *testing.T) {
+		// Federated accounts may hold no password at all
+		acc.Auth.Password = ""
+		defer func() {
+			acc.Auth.Password = string(hash)
+			acc.Lockout = models.Lockout{}
+		}()
+		err := authenticate("hello1234^")
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+		assert.Equal(t, "Wrong email or password", status.Convert(err).Message())
+		assert.Equal(t, 1, acc.Lockout.FailedAttempts)
+	})
+
+	t.Run("Resets on success", func(t *testing.T) {
+		assert.Equal(t, codes.PermissionDenied, status.Code(authenticate("wrong")))
+		assert.Equal(t, 1, acc.Lockout.FailedAttempts)
+		assert.NoError(t, authenticate("hello1234^"))
+		assert.Equal(t, models.Lockout{}, acc.Lockout)
+	})
+
+	t.Run("Locks after threshold", func(t *testing.T) {
+		for i := 0; i < 2; i++ {
+			assert.Equal(t, codes.PermissionDenied, status.Code(authenticate("wrong")))
+		}
+		assert.True(t, acc.Lockout.LockedUntil.IsZero())
+
+		err := authenticate("wrong")
+		assert.Equal(t, "Account is temporarily locked", status.Convert(err).Message())
+		assert.Equal(t, 1, acc.Lockout.Locks)
+		assert.True(t, acc.Lockout.LockedUntil.After(time.Now()))
+
+		// The correct password is refused while locked
+		err = authenticate("hello1234^")
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+		assert.Equal(t, "Account is temporarily locked", status.Convert(err).Message())
+	})
+
+	t.Run("Progressive", func(t *testing.T) {
+		acc.Lockout.LockedUntil = time.Time{}
+		for i := 0; i < 3; i++ {
+			authenticate("wrong")
+		}
+		assert.Equal(t, 2, acc.Lockout.Locks)
+		assert.True(t, acc.Lockout.LockedUntil.After(time.Now().Add(time.Minute)))
+	})
+
+	t.Run("Concurrent failures", func(t *testing.T) {
+		acc.Lockout = models.Lockout{}
+		// Each failure holds the account as read before any was counted
+		stale := *acc
+		locked := 0
+		for i := 0; i < 3; i++ {
+			ok, _, err := svc.recordFailedLogin(&stale, "")
+			assert.NoError(t, err)
+			if ok {
+				locked++
+			}
+		}
+		assert.Equal(t, 1, locked)
+		assert.Equal(t, 0, acc.Lockout.FailedAttempts)
+		assert.True(t, acc.Lockout.LockedUntil.After(time.Now()))
+	})
+
+	t.Run("Unlock requires admin scope", func(t *testing.T) {
+		scoped = false
+		defer func() { scoped = true }()
+		ctx := incomingContext(XForwardedFor, "127.0.0.1", Authorization, "Bearer token")
+		_, err := svc.UnlockAccount(ctx, &pb.UnlockAccountRequest{Id: acc.ID.Hex()})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+		assert.True(t, acc.Lockout.LockedUntil.After(time.Now()))
+	})
+
+	t.Run("Unlock", func(t *testing.T) {
+		ctx := incomingContext(XForwardedFor, "127.0.0.1", Authorization, "Bearer token")
+		_, err := svc.UnlockAccount(ctx, &pb.UnlockAccountRequest{Id: acc.ID.Hex()})
+		assert.NoError(t, err)
+		assert.Equal(t, models.Lockout{}, acc.Lockout)
+		assert.NoError(t, authenticate("hello1234^"))
+	})
+}
@@ -0,0 +1,203 @@
+package accounts
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	accountsV1 "github.com/isaiahwong/accounts-go/api/accounts/v1"
+	mailV1 "github.com/isaiahwong/accounts-go/api/mail/v1"
+	"github.com/isaiahwong/accounts-go/internal/common"
+	"github.com/isaiahwong/accounts-go/internal/common/validator"
+	"github.com/isaiahwong/accounts-go/internal/models"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// RequestMagicLink is a gRPC handler that mails a single use sign in link
+// for the login challenge to the account's email
+func (s *Service) RequestMagicLink(ctx context.Context, req *accountsV1.MagicLinkRequest) (*accountsV1.Empty, error) {
+	api := "RequestMagicLink: "
+
+	ip := common.GetMetadataValue(ctx, XForwardedFor)
+	captchaResponse := common.GetMetadataValue(ctx, CaptchaResponse)
+	challenge := common.GetMetadataValue(ctx, LoginChallenge)
+	email := strings.ToLower(strings.TrimSpace(req.GetEmail()))
+
+	errs := validator.Val(
+		s.validate,
+		validator.Field{
+			Param:   "email",
+			Message: "Invalid email",
+			Value:   email,
+			Tag:     "required,email,emailMX,max=64",
+		},
+		validator.Field{
+			Param:   CaptchaResponse,
+			Message: CaptchaResponse + " header required",
+			Value:   captchaResponse,
+			Tag:     `required`,
+		},
+		validator.Field{
+			Param:   XForwardedFor,
+			Message: XForwardedFor + " header required",
+			Value:   ip,
+			Tag:     `required`,
+		},
+		validator.Field{
+			Param:   LoginChallenge,
+			Message: LoginChallenge + " header required",
+			Value:   challenge,
+			Tag:     `required`,
+		},
+	)
+	// Validate
+	if len(errs) > 0 {
+		return nil, s.returnErrors(ctx, errs, codes.InvalidArgument, "Malformed request", api)
+	}
+	// Prepend IP for logging
+	api = fmt.Sprintf("[%v] %v", ip, api)
+
+	// Verify reCAPTCHA
+	if err := s.verifyCaptcha(captchaResponse, ip, api); err != nil {
+		return nil, err
+	}
+
+	u, err := s.findAccountByEmail(nil, email)
+	if err != nil {
+		s.logger.Errorf("%v: %v", api, err)
+		return nil, status.Error(codes.Internal, "An Internal error has occurred")
+	}
+	// Respond the same way whether or not the account exists so the
+	// endpoint cannot be used to enumerate emails
+	if u == nil {
+		s.logger.Warnf("%v: no account for %v", api, email)
+		return &accountsV1.Empty{}, nil
+	}
+
+	token, err := generateToken(32)
+	if err != nil {
+		s.logger.Errorf("%v: %v", api, err)
+		return nil, status.Error(codes.Internal, "An Internal error has occurred")
+	}
+
+	// A new link replaces any link issued before
+	_, err = s.accountsRepo.Update(
+		nil,
+		bson.M{"_id": u.ID},
+		bson.M{
+			"$set": bson.M{
+				"updated_at": time.Now(),
+				"magic_link": models.MagicLink{
+					Token:          hashToken(token),
+					LoginChallenge: hashToken(challenge),
+					Expires:        time.Now().Add(magicLinkExpiry),
+					IP:             ip,
+				},
+			},
+		},
+	)
+	if err != nil {
+		s.logger.Errorf("%v: %v", api, err)
+		return nil, status.Error(codes.Internal, "An Internal error has occurred")
+	}
+
+	_, err = s.mailSVC.SendMagicLink(ctx, &mailV1.MagicLinkRequest{
+		Email:          u.Auth.Email,
+		Token:          token,
+		LoginChallenge: challenge,
+	})
+	if err != nil {
+		s.logger.Errorf("%v: mailSVC SendMagicLink: %v", api, err)
+		return nil, status.Error(codes.Internal, "An Internal error has occurred")
+	}
+
+	return &accountsV1.Empty{}, nil
+}
+
+// RedeemMagicLink is a gRPC handler that signs in the account a magic link
+// was mailed to and accepts the login challenge it was requested for. Links
+// can only be used once.
+func (s *Service) RedeemMagicLink(ctx context.Context, req *accountsV1.RedeemMagicLinkRequest) (*accountsV1.RedirectResponse, error) {
+	api := "RedeemMagicLink: "
+
+	ip := common.GetMetadataValue(ctx, XForwardedFor)
+	challenge := common.GetMetadataValue(ctx, LoginChallenge)
+	token := strings.TrimSpace(req.GetToken())
+
+	errs := validator.Val(
+		s.validate,
+		validator.Field{
+			Param:          "token",
+			Message:        "Invalid token",
+			Value:          token,
+			Tag:            "required",
+			OmitParamValue: true,
+		},
+		validator.Field{
+			Param:   XForwardedFor,
+			Message: XForwardedFor + " header required",
+			Value:   ip,
+			Tag:     `required`,
+		},
+		validator.Field{
+			Param:   LoginChallenge,
+			Message: LoginChallenge + " header required",
+			Value:   challenge,
+			Tag:     `required`,
+		},
+	)
+	// Validate
+	if len(errs) > 0 {
+		return nil, s.returnErrors(ctx, errs, codes.InvalidArgument, "Malformed request", api)
+	}
+	// Prepend IP for logging
+	api = fmt.Sprintf("[%v] %v", ip, api)
+
+	invalidToken := []validator.Error{
+		{
+			Param:   "token",
+			Message: "Sign in link is invalid or has expired",
+		},
+	}
+
+	digest := hashToken(token)
+	u, err := s.accountsRepo.FindOne(nil, bson.M{"magic_link.token": digest})
+	if err != nil {
+		s.logger.Errorf("%v: %v", api, err)
+		return nil, status.Error(codes.Internal, "An Internal error has occurred")
+	}
+	if u == nil ||
+		!compareToken(challenge, u.MagicLink.LoginChallenge) ||
+		time.Now().After(u.MagicLink.Expires) {
+		return nil, s.returnErrors(ctx, invalidToken, codes.PermissionDenied, "Invalid token", api)
+	}
+
+	// Match on the token digest so concurrent requests cannot both
+	// redeem the same link
+	_, err = s.accountsRepo.Update(
+		nil,
+		bson.M{
+			"_id":              u.ID,
+			"magic_link.token": digest,
+		},
+		bson.M{
+			"$set": bson.M{
+				"updated_at": time.Now(),
+				"magic_link": models.MagicLink{},
+			},
+		},
+	)
+	if err == mongo.ErrNoDocuments {
+		return nil, s.returnErrors(ctx, invalidToken, codes.PermissionDenied, "Invalid token", api)
+	}
+	if err != nil {
+		s.logger.Errorf("%v: %v", api, err)
+		return nil, status.Error(codes.Internal, "An Internal error has occurred")
+	}
+
+	return s.completeLogin(ctx, challenge, u, api)
+}
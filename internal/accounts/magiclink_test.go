@@ -0,0 +1,124 @@
+package accounts
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	pb "github.com/isaiahwong/accounts-go/api/accounts/v1"
+	"github.com/isaiahwong/accounts-go/internal/models"
+	"github.com/isaiahwong/accounts-go/internal/oauth"
+	"github.com/isaiahwong/accounts-go/tests/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestMagicLink(t *testing.T) {
+	acc := &models.Account{
+		ID:   primitive.NewObjectID(),
+		Auth: models.Auth{Email: "isaiah@example.com", Verified: true},
+	}
+
+	var accepted *oauth.HydraLoginAccept
+	hydra, srv := setupHydra(func(w http.ResponseWriter, r *http.Request) {
+		accepted = &oauth.HydraLoginAccept{}
+		json.NewDecoder(r.Body).Decode(accepted)
+		json.NewEncoder(w).Encode(oauth.HydraRedirect{RedirectTo: "https://example.com/callback"})
+	})
+	defer srv.Close()
+
+	// Apply magic link updates to acc
+	repo := new(mocks.Repo)
+	repo.On("FindOne", nil, mock.MatchedBy(func(f bson.M) bool {
+		_, ok := f["$or"]
+		return ok
+	})).Return(nil, nil).Once()
+	repo.On("FindOne", nil, mock.MatchedBy(func(f bson.M) bool {
+		_, ok := f["$or"]
+		return ok
+	})).Return(acc, nil)
+	repo.On("FindOne", nil, mock.MatchedBy(func(f bson.M) bool {
+		return f["magic_link.token"] == acc.MagicLink.Token
+	})).Return(acc, nil)
+	repo.On("FindOne", nil, mock.Anything).Return(nil, nil)
+	repo.On("Update", nil, mock.Anything, mock.Anything).Return(1, func(_ context.Context, filter interface{}, update interface{}) error {
+		if d, ok := filter.(bson.M)["magic_link.token"]; ok && d != acc.MagicLink.Token {
+			return mongo.ErrNoDocuments
+		}
+		if l, ok := update.(bson.M)["$set"].(bson.M)["magic_link"].(models.MagicLink); ok {
+			acc.MagicLink = l
+		}
+		return nil
+	})
+
+	mail := &mailStub{}
+	svc := &Service{
+		logger:       logger,
+		accountsRepo: repo,
+		oAuthClient:  hydra,
+		mailSVC:      mail,
+	}
+	svc.initValidator()
+
+	ctx := incomingContext(
+		XForwardedFor, "127.0.0.1",
+		CaptchaResponse, "captcha",
+		LoginChallenge, "challenge",
+	)
+
+	t.Run("Unknown email", func(t *testing.T) {
+		_, err := svc.RequestMagicLink(ctx, &pb.MagicLinkRequest{Email: "isaiah@example.com"})
+		assert.NoError(t, err)
+		assert.Empty(t, mail.links)
+	})
+
+	t.Run("Missing challenge", func(t *testing.T) {
+		ctx := incomingContext(
+			XForwardedFor, "127.0.0.1",
+			CaptchaResponse, "captcha",
+		)
+		_, err := svc.RequestMagicLink(ctx, &pb.MagicLinkRequest{Email: "isaiah@example.com"})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	var token string
+	t.Run("Request", func(t *testing.T) {
+		_, err := svc.RequestMagicLink(ctx, &pb.MagicLinkRequest{Email: "Isaiah@example.com"})
+		assert.NoError(t, err)
+		if assert.Len(t, mail.links, 1) {
+			token = mail.links[0].GetToken()
+			assert.Equal(t, "challenge", mail.links[0].GetLoginChallenge())
+		}
+		// Only digests are stored
+		assert.Equal(t, hashToken(token), acc.MagicLink.Token)
+		assert.Equal(t, hashToken("challenge"), acc.MagicLink.LoginChallenge)
+	})
+
+	t.Run("Other challenge", func(t *testing.T) {
+		other := incomingContext(
+			XForwardedFor, "127.0.0.1",
+			LoginChallenge, "other",
+		)
+		_, err := svc.RedeemMagicLink(other, &pb.RedeemMagicLinkRequest{Token: token})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+
+	t.Run("Redeem", func(t *testing.T) {
+		resp, err := svc.RedeemMagicLink(ctx, &pb.RedeemMagicLinkRequest{Token: token})
+		assert.NoError(t, err)
+		assert.Equal(t, "https://example.com/callback", resp.GetRedirectTo())
+		assert.Equal(t, acc.ID.Hex(), accepted.Subject)
+		assert.Equal(t, oauth.ACRSingleFactor, accepted.Acr)
+	})
+
+	t.Run("Single use", func(t *testing.T) {
+		_, err := svc.RedeemMagicLink(ctx, &pb.RedeemMagicLinkRequest{Token: token})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+}
@@ -0,0 +1,269 @@
+package accounts
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	accountsV1 "github.com/isaiahwong/accounts-go/api/accounts/v1"
+	"github.com/isaiahwong/accounts-go/internal/common"
+	"github.com/isaiahwong/accounts-go/internal/common/totp"
+	"github.com/isaiahwong/accounts-go/internal/common/validator"
+	"github.com/isaiahwong/accounts-go/internal/models"
+	"github.com/isaiahwong/accounts-go/internal/oauth"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// maxMFAAttempts defines the number of wrong codes allowed for a pending
+// login before it is discarded
+const maxMFAAttempts = 5
+
+// VerifyMFA is a gRPC handler that completes a login challenge which
+// Authenticate deferred for a second factor
+func (s *Service) VerifyMFA(ctx context.Context, req *accountsV1.VerifyMFARequest) (*accountsV1.RedirectResponse, error) {
+	api := "VerifyMFA: "
+
+	ip := common.GetMetadataValue(ctx, XForwardedFor)
+	challenge := common.GetMetadataValue(ctx, LoginChallenge)
+	code := strings.TrimSpace(req.GetCode())
+
+	errs := validator.Val(
+		s.validate,
+		validator.Field{
+			Param:          "code",
+			Message:        "Invalid code",
+			Value:          code,
+			Tag:            "required,numeric,len=6",
+			OmitParamValue: true,
+		},
+		validator.Field{
+			Param:   LoginChallenge,
+			Message: LoginChallenge + " header required",
+			Value:   challenge,
+			Tag:     `required`,
+		},
+		validator.Field{
+			Param:   XForwardedFor,
+			Message: XForwardedFor + " header required",
+			Value:   ip,
+			Tag:     `required`,
+		},
+	)
+	// Validate
+	if len(errs) > 0 {
+		return nil, s.returnErrors(ctx, errs, codes.InvalidArgument, "Malformed request", api)
+	}
+	// Prepend IP for logging
+	api = fmt.Sprintf("[%v] %v", ip, api)
+
+	u, err := s.findPendingLogin(challenge)
+	if err != nil {
+		s.logger.Errorf("%v: %v", api, err)
+		return nil, status.Error(codes.Internal, "An Internal error has occurred")
+	}
+	if u == nil || !u.MFA.Enabled || u.PendingLogin.Attempts >= maxMFAAttempts {
+		return nil, status.Error(codes.PermissionDenied, "Login session has expired. Please sign in again")
+	}
+
+	step, ok := totp.Validate(u.MFA.TOTPSecret, code, time.Now())
+	if ok {
+		// Codes can only be used once within their validity window
+		_, err = s.accountsRepo.Update(
+			nil,
+			bson.M{"_id": u.ID, "mfa.totp_step": bson.M{"$lt": step}},
+			bson.M{"$set": bson.M{"mfa.totp_step": step}},
+		)
+		if err == mongo.ErrNoDocuments {
+			ok = false
+		} else if err != nil {
+			s.logger.Errorf("%v: %v", api, err)
+			return nil, status.Error(codes.Internal, "An Internal error has occurred")
+		}
+	}
+	if !ok {
+		return nil, s.rejectMFACode(ctx, u, api)
+	}
+
+	amr := append(u.PendingLogin.AMR, oauth.AMROTP, oauth.AMRMFA)
+	r, err := s.acceptLogin(challenge, u, oauth.ACRMultiFactor, amr...)
+	if err != nil {
+		s.logger.Errorf("%v: acceptLogin: %v", api, err)
+		// Cast  to hydra error
+		if he, ok := err.(*oauth.HydraError); ok {
+			return nil, s.returnHydraError(ctx, he, api)
+		}
+		return nil, status.Error(codes.Internal, "An Internal error has occurred")
+	}
+
+	return &accountsV1.RedirectResponse{RedirectTo: r.RedirectTo}, nil
+}
+
+// rejectMFACode counts a failed attempt against the pending login
+func (s *Service) rejectMFACode(ctx context.Context, u *models.Account, prefix string) error {
+	_, err := s.accountsRepo.Update(
+		nil,
+		bson.M{"_id": u.ID},
+		bson.M{"$inc": bson.M{"pending_login.attempts": 1}},
+	)
+	if err != nil {
+		s.logger.Errorf("%v: %v", prefix, err)
+	}
+	return s.returnErrors(ctx, []validator.Error{
+		{
+			Param:   "code",
+			Message: "Invalid code",
+		},
+	}, codes.PermissionDenied, "Invalid code", prefix)
+}
+
+// EnrollTOTP is a gRPC handler that issues a new TOTP secret for the
+// authenticated account. The secret is only enabled once confirmed through
+// ConfirmTOTP.
+func (s *Service) EnrollTOTP(ctx context.Context, _ *accountsV1.Empty) (*accountsV1.EnrollTOTPResponse, error) {
+	api := "EnrollTOTP: "
+
+	u, err := s.bearerRequest(ctx, &api)
+	if err != nil {
+		return nil, err
+	}
+	if u.MFA.Enabled {
+		return nil, status.Error(codes.FailedPrecondition, "Two-factor authentication is already enabled")
+	}
+
+	secret, err := totp.GenerateSecret()
+	if err != nil {
+		s.logger.Errorf("%v: %v", api, err)
+		return nil, status.Error(codes.Internal, "An Internal error has occurred")
+	}
+	_, err = s.accountsRepo.Update(
+		nil,
+		bson.M{"_id": u.ID},
+		bson.M{
+			"$set": bson.M{
+				"updated_at":       time.Now(),
+				"mfa.totp_pending": secret,
+			},
+		},
+	)
+	if err != nil {
+		s.logger.Errorf("%v: %v", api, err)
+		return nil, status.Error(codes.Internal, "An Internal error has occurred")
+	}
+
+	return &accountsV1.EnrollTOTPResponse{
+		Secret: secret,
+		Url:    totp.URL(s.totpIssuer, u.Auth.Email, secret),
+	}, nil
+}
+
+// ConfirmTOTP is a gRPC handler that enables the pending TOTP secret once the
+// account proves it can generate codes for it
+func (s *Service) ConfirmTOTP(ctx context.Context, req *accountsV1.TOTPRequest) (*accountsV1.Empty, error) {
+	api := "ConfirmTOTP: "
+
+	u, code, err := s.totpRequest(ctx, req, &api)
+	if err != nil {
+		return nil, err
+	}
+	if u.MFA.Enabled || u.MFA.TOTPPending == "" {
+		return nil, status.Error(codes.FailedPrecondition, "No pending two-factor enrollment")
+	}
+
+	step, ok := totp.Validate(u.MFA.TOTPPending, code, time.Now())
+	if !ok {
+		return nil, s.returnErrors(ctx, []validator.Error{
+			{
+				Param:   "code",
+				Message: "Invalid code",
+			},
+		}, codes.InvalidArgument, "Invalid code", api)
+	}
+
+	_, err = s.accountsRepo.Update(
+		nil,
+		bson.M{"_id": u.ID},
+		bson.M{
+			"$set": bson.M{
+				"updated_at": time.Now(),
+				"mfa": models.MFA{
+					Enabled:     true,
+					EnabledDate: time.Now(),
+					TOTPSecret:  u.MFA.TOTPPending,
+					TOTPStep:    step,
+				},
+			},
+		},
+	)
+	if err != nil {
+		s.logger.Errorf("%v: %v", api, err)
+		return nil, status.Error(codes.Internal, "An Internal error has occurred")
+	}
+
+	return &accountsV1.Empty{}, nil
+}
+
+// DisableTOTP is a gRPC handler that removes the TOTP second factor. A
+// current code is required.
+func (s *Service) DisableTOTP(ctx context.Context, req *accountsV1.TOTPRequest) (*accountsV1.Empty, error) {
+	api := "DisableTOTP: "
+
+	u, code, err := s.totpRequest(ctx, req, &api)
+	if err != nil {
+		return nil, err
+	}
+	if !u.MFA.Enabled {
+		return nil, status.Error(codes.FailedPrecondition, "Two-factor authentication is not enabled")
+	}
+
+	if _, ok := totp.Validate(u.MFA.TOTPSecret, code, time.Now()); !ok {
+		return nil, s.returnErrors(ctx, []validator.Error{
+			{
+				Param:   "code",
+				Message: "Invalid code",
+			},
+		}, codes.InvalidArgument, "Invalid code", api)
+	}
+
+	_, err = s.accountsRepo.Update(
+		nil,
+		bson.M{"_id": u.ID},
+		bson.M{
+			"$set": bson.M{
+				"updated_at": time.Now(),
+				"mfa":        models.MFA{},
+			},
+		},
+	)
+	if err != nil {
+		s.logger.Errorf("%v: %v", api, err)
+		return nil, status.Error(codes.Internal, "An Internal error has occurred")
+	}
+
+	return &accountsV1.Empty{}, nil
+}
+
+// totpRequest validates a TOTPRequest and resolves the authenticated
+// account. api is prefixed with the client's IP for logging.
+func (s *Service) totpRequest(ctx context.Context, req *accountsV1.TOTPRequest, api *string) (*models.Account, string, error) {
+	code := strings.TrimSpace(req.GetCode())
+
+	errs := validator.Val(
+		s.validate,
+		validator.Field{
+			Param:          "code",
+			Message:        "Invalid code",
+			Value:          code,
+			Tag:            "required,numeric,len=6",
+			OmitParamValue: true,
+		},
+	)
+	u, err := s.bearerRequest(ctx, api, errs...)
+	if err != nil {
+		return nil, "", err
+	}
+	return u, code, nil
+}
@@ -0,0 +1,85 @@
+package accounts
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	pb "github.com/isaiahwong/accounts-go/api/accounts/v1"
+	"github.com/isaiahwong/accounts-go/internal/common/totp"
+	"github.com/isaiahwong/accounts-go/internal/models"
+	"github.com/isaiahwong/accounts-go/internal/oauth"
+	"github.com/isaiahwong/accounts-go/tests/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"golang.org/x/crypto/bcrypt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestMFA(t *testing.T) {
+	secret, _ := totp.GenerateSecret()
+	hash, _ := bcrypt.GenerateFromPassword([]byte(validPasswords[0]), bcrypt.MinCost)
+	acc := &models.Account{
+		ID: primitive.NewObjectID(),
+		Auth: models.Auth{
+			Email:    "isaiah@example.com",
+			Password: string(hash),
+		},
+		MFA: models.MFA{
+			Enabled:    true,
+			TOTPSecret: secret,
+		},
+	}
+
+	var accepted *oauth.HydraLoginAccept
+	hydra, srv := setupHydra(func(w http.ResponseWriter, r *http.Request) {
+		accepted = &oauth.HydraLoginAccept{}
+		json.NewDecoder(r.Body).Decode(accepted)
+		json.NewEncoder(w).Encode(oauth.HydraRedirect{RedirectTo: "https://example.com/callback"})
+	})
+	defer srv.Close()
+
+	repo := new(mocks.Repo)
+	repo.On("FindOne", nil, mock.Anything).Return(acc, nil)
+	repo.On("Update", nil, mock.Anything, mock.Anything).Return(1, nil)
+	svc := &Service{
+		logger:       logger,
+		accountsRepo: repo,
+		hashers:      testHashers,
+		oAuthClient:  hydra,
+	}
+	svc.initValidator()
+	ctx := incomingContext(
+		XForwardedFor, "127.0.0.1",
+		CaptchaResponse, "captcha",
+		LoginChallenge, "challenge",
+	)
+
+	t.Run("Authenticate requires second factor", func(t *testing.T) {
+		resp, err := svc.Authenticate(ctx, &pb.AuthenticateRequest{
+			Email:    acc.Auth.Email,
+			Password: validPasswords[0],
+		})
+		assert.NoError(t, err)
+		assert.True(t, resp.GetMfaRequired())
+		assert.Empty(t, resp.GetRedirectTo())
+		assert.Nil(t, accepted, "login should not be accepted")
+	})
+
+	t.Run("Wrong code", func(t *testing.T) {
+		_, err := svc.VerifyMFA(ctx, &pb.VerifyMFARequest{Code: "000000"})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+
+	t.Run("Valid code", func(t *testing.T) {
+		code, _ := totp.Code(secret, totp.Step(time.Now()))
+		resp, err := svc.VerifyMFA(ctx, &pb.VerifyMFARequest{Code: code})
+		assert.NoError(t, err)
+		assert.Equal(t, "https://example.com/callback", resp.GetRedirectTo())
+		assert.Equal(t, oauth.ACRMultiFactor, accepted.Acr)
+		assert.Contains(t, accepted.Amr, oauth.AMROTP)
+	})
+}
@@ -2,13 +2,26 @@ package accounts
 
 import (
 	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
 	"encoding/json"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
 
+	accountsV1 "github.com/isaiahwong/accounts-go/api/accounts/v1"
+	"github.com/isaiahwong/accounts-go/internal/common"
+	"github.com/isaiahwong/accounts-go/internal/common/recaptcha"
 	"github.com/isaiahwong/accounts-go/internal/common/validator"
 	"github.com/isaiahwong/accounts-go/internal/models"
 	"github.com/isaiahwong/accounts-go/internal/oauth"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
@@ -91,3 +104,365 @@ func (s *Service) findAccountByID(ctx context.Context, id string) (*models.Accou
 	}
 	return u, nil
 }
+
+// generateToken returns a url safe random string of n bytes
+func generateToken(n int) (string, error) {
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// generateCode returns a random numeric code of n digits
+func generateCode(n int) (string, error) {
+	max := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(n)), nil)
+	v, err := rand.Int(rand.Reader, max)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%0*d", n, v), nil
+}
+
+// hashToken returns the hex encoded SHA-256 digest of token. Tokens are only
+// persisted as digests so a leaked document cannot be replayed.
+func hashToken(token string) string {
+	h := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(h[:])
+}
+
+// compareToken reports whether token matches the stored digest in constant time
+func compareToken(token, digest string) bool {
+	if digest == "" {
+		return false
+	}
+	return subtle.ConstantTimeCompare([]byte(hashToken(token)), []byte(digest)) == 1
+}
+
+// bearerToken extracts the token from an authorization header value of the
+// form "Bearer <token>". An empty string is returned if the scheme differs.
+func bearerToken(header string) string {
+	parts := strings.Fields(header)
+	if len(parts) != 2 || !strings.EqualFold(parts[0], "bearer") {
+		return ""
+	}
+	return parts[1]
+}
+
+// validateBearer validates the headers of a request authenticated with a
+// bearer token and returns the token. errs are those already found in the
+// request's body so every error is reported at once. api is prefixed with
+// the client's IP for logging.
+func (s *Service) validateBearer(ctx context.Context, api *string, errs ...validator.Error) (string, error) {
+	token := bearerToken(common.GetMetadataValue(ctx, Authorization))
+	ip := common.GetMetadataValue(ctx, XForwardedFor)
+
+	errs = append(errs, validator.Val(
+		s.validate,
+		validator.Field{
+			Param:          Authorization,
+			Message:        Authorization + " bearer token required",
+			Value:          token,
+			Tag:            `required`,
+			OmitParamValue: true,
+		},
+		validator.Field{
+			Param:   XForwardedFor,
+			Message: XForwardedFor + " header required",
+			Value:   ip,
+			Tag:     `required`,
+		},
+	)...)
+	// Validate
+	if len(errs) > 0 {
+		return "", s.returnErrors(ctx, errs, codes.InvalidArgument, "Malformed request", *api)
+	}
+	// Prepend IP for logging
+	*api = fmt.Sprintf("[%v] %v", ip, *api)
+	return token, nil
+}
+
+// bearerRequest validates a request authenticated with a bearer token as
+// validateBearer does and resolves the account the token was issued to
+func (s *Service) bearerRequest(ctx context.Context, api *string, errs ...validator.Error) (*models.Account, error) {
+	token, err := s.validateBearer(ctx, api, errs...)
+	if err != nil {
+		return nil, err
+	}
+	u, _, err := s.accountFromToken(ctx, token, *api)
+	if err != nil {
+		return nil, err
+	}
+	return u, nil
+}
+
+// accountFromToken introspects a bearer token and returns the account it was
+// issued to. Errors returned are gRPC status errors.
+func (s *Service) accountFromToken(ctx context.Context, token string, prefix string) (*models.Account, *oauth.InstrospectResponse, error) {
+	resp, err := s.oAuthClient.Introspect(token, "")
+	if err != nil {
+		s.logger.Errorf("%v: %v", prefix, err)
+		// Cast  to hydra error
+		if he, ok := err.(*oauth.HydraError); ok {
+			return nil, nil, s.returnHydraError(ctx, he, prefix)
+		}
+		return nil, nil, status.Error(codes.Internal, "An Internal error has occurred")
+	}
+	if !resp.Active || resp.Sub == "" {
+		return nil, nil, status.Error(codes.Unauthenticated, "Token is not active")
+	}
+
+	fctx, cancel := context.WithTimeout(ctx, s.accountsRepo.GetTimeout())
+	defer cancel()
+	u, err := s.findAccountByID(fctx, resp.Sub)
+	if err != nil {
+		s.logger.Errorf("%v: %v", prefix, err)
+		return nil, nil, status.Error(codes.Internal, "An Internal error has occurred")
+	}
+	if u == nil {
+		// Token was issued to an account that no longer exists
+		s.logger.Warnf("%v: no account for subject %v", prefix, resp.Sub)
+		return nil, nil, status.Error(codes.Unauthenticated, "Token is not active")
+	}
+	return u, resp, nil
+}
+
+// screenPassword rejects passwords found in the breach corpus with a
+// validator.Error on "password". Screening is disabled without a corpus.
+func (s *Service) screenPassword(ctx context.Context, password string, prefix string) error {
+	if s.breached == nil {
+		return nil
+	}
+	found, err := s.breached.Contains(password)
+	if err != nil {
+		s.logger.Errorf("%v: breach corpus: %v", prefix, err)
+		return status.Error(codes.Internal, "An Internal error has occurred")
+	}
+	if found {
+		return s.returnErrors(ctx, []validator.Error{
+			{
+				Param:   "password",
+				Message: "Password has appeared in a data breach, choose another",
+			},
+		}, codes.InvalidArgument, "Password has appeared in a data breach", prefix)
+	}
+	return nil
+}
+
+// checkPassword returns an error on password for every rule of the password
+// policy it violates so clients can show which rules are unmet. The rule is
+// set as the error's value. personal holds the account's email and names.
+func (s *Service) checkPassword(password string, personal ...string) []validator.Error {
+	var errs []validator.Error
+	for _, v := range s.passwordPolicy.Check(password, personal...) {
+		errs = append(errs, validator.Error{
+			Param:   "password",
+			Message: v.Message,
+			Value:   v.Rule,
+		})
+	}
+	return errs
+}
+
+// verifyPassword verifies password against the account's hash. Passwords
+// set before normalization was configured were hashed as entered, so the
+// password is also tried unnormalized and rehash is set if it matches.
+func (s *Service) verifyPassword(u *models.Account, password string) (ok bool, rehash bool, err error) {
+	normalized := s.passwordPolicy.Normalize(password)
+	ok, rehash, err = s.hashers.Verify(normalized, u.Auth.Password, u.Auth.PasswordHashMethod)
+	if err != nil || ok || normalized == password {
+		return ok, rehash, err
+	}
+	ok, _, err = s.hashers.Verify(password, u.Auth.Password, u.Auth.PasswordHashMethod)
+	return ok, ok, err
+}
+
+// rehashPassword replaces the account's password hash with one created by
+// the default hasher. The update is skipped if the password has changed
+// since u was read. The password itself is unchanged so it does not age
+// afresh.
+func (s *Service) rehashPassword(u *models.Account, password string) error {
+	hash, method, err := s.hashers.Hash(password)
+	if err != nil {
+		return err
+	}
+	_, err = s.accountsRepo.Update(
+		nil,
+		bson.M{
+			"_id":           u.ID,
+			"auth.password": u.Auth.Password,
+		},
+		bson.M{
+			"$set": bson.M{
+				"updated_at":                time.Now(),
+				"auth.password":             hash,
+				"auth.password_hash_method": method,
+			},
+		},
+	)
+	if err == mongo.ErrNoDocuments {
+		return nil
+	}
+	return err
+}
+
+// requireScope introspects a bearer token and checks it was granted scope.
+// Hydra reports tokens without the scope as inactive. Errors returned are
+// gRPC status errors.
+func (s *Service) requireScope(ctx context.Context, token, scope string, prefix string) error {
+	resp, err := s.oAuthClient.Introspect(token, scope)
+	if err != nil {
+		s.logger.Errorf("%v: %v", prefix, err)
+		// Cast  to hydra error
+		if he, ok := err.(*oauth.HydraError); ok {
+			return s.returnHydraError(ctx, he, prefix)
+		}
+		return status.Error(codes.Internal, "An Internal error has occurred")
+	}
+	if !resp.Active {
+		return status.Error(codes.PermissionDenied, "Token is not granted "+scope)
+	}
+	return nil
+}
+
+// acceptLogin accepts the hydra login challenge on behalf of the account and
+// records the login. amr lists the authentication methods used.
+func (s *Service) acceptLogin(challenge string, u *models.Account, acr string, amr ...string) (*oauth.HydraRedirect, error) {
+	r, err := s.oAuthClient.AcceptLogin(challenge, &oauth.HydraLoginAccept{
+		Subject:     u.ID.Hex(),
+		Acr:         acr,
+		Amr:         amr,
+		Remember:    true,
+		RememberFor: 0, // TODO: Change with env variable
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	u.LoggedIn = time.Now()
+	_, err = s.accountsRepo.Update(
+		nil,
+		bson.M{"_id": u.ID},
+		bson.M{
+			"$set": bson.M{
+				"updated_at":    time.Now(),
+				"logged_in":     time.Now(),
+				"pending_login": models.PendingLogin{},
+			},
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// setPendingLogin binds challenge to the account so the login can be
+// completed by a further step. amr lists the methods already completed.
+func (s *Service) setPendingLogin(u *models.Account, challenge string, amr ...string) error {
+	_, err := s.accountsRepo.Update(
+		nil,
+		bson.M{"_id": u.ID},
+		bson.M{
+			"$set": bson.M{
+				"updated_at": time.Now(),
+				"pending_login": models.PendingLogin{
+					Challenge: hashToken(challenge),
+					Expires:   time.Now().Add(pendingLoginExpiry),
+					AMR:       amr,
+				},
+			},
+		},
+	)
+	return err
+}
+
+// setPasswordChangeLogin binds challenge to the account until its expired
+// password is changed. amr lists the methods already completed.
+func (s *Service) setPasswordChangeLogin(u *models.Account, challenge string, amr ...string) error {
+	_, err := s.accountsRepo.Update(
+		nil,
+		bson.M{"_id": u.ID},
+		bson.M{
+			"$set": bson.M{
+				"updated_at": time.Now(),
+				"pending_login": models.PendingLogin{
+					Challenge:      hashToken(challenge),
+					Expires:        time.Now().Add(pendingLoginExpiry),
+					AMR:            amr,
+					PasswordChange: true,
+				},
+			},
+		},
+	)
+	return err
+}
+
+// findPendingLogin returns the account with an unexpired pending login bound
+// to challenge. Logins awaiting a password change are not returned.
+func (s *Service) findPendingLogin(challenge string) (*models.Account, error) {
+	return s.accountsRepo.FindOne(nil, bson.M{
+		"pending_login.challenge":       hashToken(challenge),
+		"pending_login.expires":         bson.M{"$gt": time.Now()},
+		"pending_login.password_change": bson.M{"$ne": true},
+	})
+}
+
+// findPasswordChangeLogin returns the account with an unexpired pending
+// login bound to challenge awaiting a password change
+func (s *Service) findPasswordChangeLogin(challenge string) (*models.Account, error) {
+	return s.accountsRepo.FindOne(nil, bson.M{
+		"pending_login.challenge":       hashToken(challenge),
+		"pending_login.expires":         bson.M{"$gt": time.Now()},
+		"pending_login.password_change": true,
+	})
+}
+
+// mfaMethods returns the second factors enabled for the account
+func mfaMethods(u *models.Account) []string {
+	var methods []string
+	if u.MFA.Enabled {
+		methods = append(methods, "totp")
+	}
+	if u.Phone.MFA && u.Phone.Verified {
+		methods = append(methods, "sms")
+	}
+	return methods
+}
+
+// completeLogin accepts the login challenge for an account which has
+// completed a single factor, deferring to the second factor when enabled.
+// amr lists the methods completed. Errors returned are gRPC status errors.
+func (s *Service) completeLogin(ctx context.Context, challenge string, u *models.Account, prefix string, amr ...string) (*accountsV1.RedirectResponse, error) {
+	if methods := mfaMethods(u); len(methods) > 0 {
+		if err := s.setPendingLogin(u, challenge, amr...); err != nil {
+			s.logger.Errorf("%v: %v", prefix, err)
+			return nil, status.Error(codes.Internal, "An Internal error has occurred")
+		}
+		return &accountsV1.RedirectResponse{MfaRequired: true, MfaMethods: methods}, nil
+	}
+
+	r, err := s.acceptLogin(challenge, u, oauth.ACRSingleFactor, amr...)
+	if err != nil {
+		s.logger.Errorf("%v: acceptLogin: %v", prefix, err)
+		// Cast  to hydra error
+		if he, ok := err.(*oauth.HydraError); ok {
+			return nil, s.returnHydraError(ctx, he, prefix)
+		}
+		return nil, status.Error(codes.Internal, "An Internal error has occurred")
+	}
+	return &accountsV1.RedirectResponse{RedirectTo: r.RedirectTo}, nil
+}
+
+// verifyCaptcha verifies the reCAPTCHA response in production
+func (s *Service) verifyCaptcha(captchaResponse, ip string, prefix string) error {
+	if !s.production {
+		return nil
+	}
+	rcpResp, err := recaptcha.Verify(captchaResponse, ip)
+	if err != nil || !rcpResp.Success {
+		s.logger.Errorf("%v: recaptcha verify: %v", prefix, err)
+		return status.Error(codes.InvalidArgument, "Captcha Verification Failed")
+	}
+	return nil
+}
@@ -0,0 +1,210 @@
+package accounts
+
+import (
+	"context"
+	"html"
+	"strings"
+	"time"
+
+	accountsV1 "github.com/isaiahwong/accounts-go/api/accounts/v1"
+	"github.com/isaiahwong/accounts-go/internal/common/validator"
+	"github.com/isaiahwong/accounts-go/internal/models"
+	"go.mongodb.org/mongo-driver/bson"
+	"golang.org/x/text/language"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// profilePaths are the update mask paths UpdateMyProfile accepts
+var profilePaths = map[string]bool{
+	"first_name":                      true,
+	"last_name":                       true,
+	"picture":                         true,
+	"preferences":                     true,
+	"preferences.language":            true,
+	"preferences.email_notifications": true,
+	"preferences.push_notifications":  true,
+}
+
+// profile builds the profile of an account returned to its owner
+func profile(u *models.Account) *accountsV1.Profile {
+	return &accountsV1.Profile{
+		Id:            u.ID.Hex(),
+		Email:         u.Auth.Email,
+		EmailVerified: u.Auth.Verified,
+		PendingEmail:  u.EmailChange.Pending,
+		FirstName:     u.Auth.FirstName,
+		LastName:      u.Auth.LastName,
+		Name:          u.Auth.Name,
+		Picture:       u.Auth.Picture,
+		Preferences: &accountsV1.Preferences{
+			Language: u.Preferences.Language,
+			EmailNotifications: &accountsV1.Preferences_EmailNotifications{
+				UnsubscribeFromAll: u.Preferences.EmailNotifications.UnsubscribeFromAll,
+			},
+			PushNotifications: &accountsV1.Preferences_PushNotifications{
+				UnsubscribeFromAll: u.Preferences.PushNotifications.UnsubscribeFromAll,
+			},
+		},
+		CreatedAt: u.CreatedAt.Unix(),
+		UpdatedAt: u.UpdatedAt.Unix(),
+	}
+}
+
+// GetMyProfile is a gRPC handler that returns the profile of the account
+// the bearer token was issued to
+func (s *Service) GetMyProfile(ctx context.Context, _ *accountsV1.Empty) (*accountsV1.Profile, error) {
+	api := "GetMyProfile: "
+
+	u, err := s.bearerRequest(ctx, &api)
+	if err != nil {
+		return nil, err
+	}
+	return profile(u), nil
+}
+
+// UpdateMyProfile is a gRPC handler that updates the fields of the bearer's
+// profile named by the update mask. Names are sanitized and the account's
+// full name is recomputed from them.
+func (s *Service) UpdateMyProfile(ctx context.Context, req *accountsV1.UpdateProfileRequest) (*accountsV1.Profile, error) {
+	api := "UpdateMyProfile: "
+
+	p := req.GetProfile()
+	firstname := s.policy.Sanitize(strings.TrimSpace(p.GetFirstName()))
+	lastname := s.policy.Sanitize(strings.TrimSpace(p.GetLastName()))
+	picture := strings.TrimSpace(p.GetPicture())
+	lang := strings.TrimSpace(p.GetPreferences().GetLanguage())
+
+	paths := []string{}
+	mask := map[string]bool{}
+	for _, path := range req.GetUpdateMask().GetPaths() {
+		path = strings.TrimSpace(path)
+		if !mask[path] {
+			mask[path] = true
+			paths = append(paths, path)
+		}
+	}
+
+	fields := []validator.Field{
+		{
+			Param:          "update_mask",
+			Message:        "Update mask required",
+			Value:          paths,
+			Tag:            `min=1`,
+			OmitParamValue: true,
+		},
+	}
+	if mask["first_name"] {
+		fields = append(fields, validator.Field{
+			Param:   "first_name",
+			Message: "Invalid first name",
+			Value:   firstname,
+			Tag:     "required,max=64",
+		})
+	}
+	if mask["last_name"] {
+		fields = append(fields, validator.Field{
+			Param:   "last_name",
+			Message: "Invalid last name",
+			Value:   lastname,
+			Tag:     "required,max=64",
+		})
+	}
+	if mask["picture"] {
+		fields = append(fields, validator.Field{
+			Param:   "picture",
+			Message: "Invalid picture",
+			Value:   picture,
+			Tag:     "omitempty,url,startswith=https://,max=2048",
+		})
+	}
+	errs := validator.Val(s.validate, fields...)
+	for _, path := range paths {
+		if !profilePaths[path] {
+			errs = append(errs, validator.Error{
+				Param:   "update_mask",
+				Message: "Field cannot be updated",
+				Value:   path,
+			})
+		}
+	}
+	// URLs are escaped by the policy so only check they hold no markup
+	if mask["picture"] && html.UnescapeString(s.policy.Sanitize(picture)) != picture {
+		errs = append(errs, validator.Error{
+			Param:   "picture",
+			Message: "Invalid picture",
+			Value:   picture,
+		})
+	}
+	if lang != "" && (mask["preferences"] || mask["preferences.language"]) {
+		tag, err := language.Parse(lang)
+		if err != nil {
+			errs = append(errs, validator.Error{
+				Param:   "preferences.language",
+				Message: "Invalid language",
+				Value:   lang,
+			})
+		}
+		lang = tag.String()
+	}
+	u, err := s.bearerRequest(ctx, &api, errs...)
+	if err != nil {
+		return nil, err
+	}
+
+	// Apply the mask to u so the response reflects the update
+	set := bson.M{}
+	if mask["first_name"] {
+		u.Auth.FirstName = firstname
+		set["auth.first_name"] = firstname
+	}
+	if mask["last_name"] {
+		u.Auth.LastName = lastname
+		set["auth.last_name"] = lastname
+	}
+	if mask["first_name"] || mask["last_name"] {
+		u.Auth.Name = strings.TrimSpace(u.Auth.FirstName + " " + u.Auth.LastName)
+		set["auth.name"] = u.Auth.Name
+	}
+	if mask["picture"] {
+		u.Auth.Picture = picture
+		set["auth.picture"] = picture
+	}
+	pref := p.GetPreferences()
+	if mask["preferences"] {
+		u.Preferences = models.Preferences{
+			Language: lang,
+			EmailNotifications: models.EmailNotifications{
+				UnsubscribeFromAll: pref.GetEmailNotifications().GetUnsubscribeFromAll(),
+			},
+			PushNotifications: models.PushNotifications{
+				UnsubscribeFromAll: pref.GetPushNotifications().GetUnsubscribeFromAll(),
+			},
+		}
+		set["preferences"] = u.Preferences
+	} else {
+		// Setting a field and its parent would conflict
+		if mask["preferences.language"] {
+			u.Preferences.Language = lang
+			set["preferences.language"] = lang
+		}
+		if mask["preferences.email_notifications"] {
+			u.Preferences.EmailNotifications.UnsubscribeFromAll = pref.GetEmailNotifications().GetUnsubscribeFromAll()
+			set["preferences.email_notifications"] = u.Preferences.EmailNotifications
+		}
+		if mask["preferences.push_notifications"] {
+			u.Preferences.PushNotifications.UnsubscribeFromAll = pref.GetPushNotifications().GetUnsubscribeFromAll()
+			set["preferences.push_notification"] = u.Preferences.PushNotifications
+		}
+	}
+	u.UpdatedAt = time.Now()
+	set["updated_at"] = u.UpdatedAt
+
+	_, err = s.accountsRepo.Update(nil, bson.M{"_id": u.ID}, bson.M{"$set": set})
+	if err != nil {
+		s.logger.Errorf("%v: %v", api, err)
+		return nil, status.Error(codes.Internal, "An Internal error has occurred")
+	}
+
+	return profile(u), nil
+}
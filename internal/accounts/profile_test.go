@@ -0,0 +1,148 @@
+package accounts
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	pb "github.com/isaiahwong/accounts-go/api/accounts/v1"
+	"github.com/isaiahwong/accounts-go/internal/models"
+	"github.com/isaiahwong/accounts-go/internal/oauth"
+	"github.com/isaiahwong/accounts-go/tests/mocks"
+	"github.com/microcosm-cc/bluemonday"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+func TestProfile(t *testing.T) {
+	acc := &models.Account{
+		ID: primitive.NewObjectID(),
+		Auth: models.Auth{
+			Email:     "isaiah@example.com",
+			FirstName: "Isaiah",
+			LastName:  "Wong",
+			Name:      "Isaiah Wong",
+			Verified:  true,
+		},
+		Preferences: models.Preferences{Language: "en"},
+	}
+
+	hydra, srv := setupHydra(func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(oauth.InstrospectResponse{Active: true, Sub: acc.ID.Hex(), ClientID: "web"})
+	})
+	defer srv.Close()
+
+	var sets []bson.M
+	repo := new(mocks.Repo)
+	repo.On("GetTimeout").Return(time.Second)
+	repo.On("FindOne", mock.Anything, mock.Anything).Return(func(context.Context, interface{}, ...interface{}) *models.Account {
+		// Return a copy as handlers modify the account they are given
+		u := *acc
+		return &u
+	}, nil)
+	repo.On("Update", nil, mock.Anything, mock.Anything).Return(1, func(_ context.Context, filter interface{}, update interface{}) error {
+		sets = append(sets, update.(bson.M)["$set"].(bson.M))
+		return nil
+	})
+
+	svc := &Service{
+		logger:       logger,
+		policy:       bluemonday.StrictPolicy(),
+		accountsRepo: repo,
+		oAuthClient:  hydra,
+	}
+	svc.initValidator()
+	ctx := incomingContext(
+		XForwardedFor, "127.0.0.1",
+		Authorization, "Bearer token",
+	)
+	update := func(p *pb.Profile, paths ...string) (*pb.Profile, error) {
+		return svc.UpdateMyProfile(ctx, &pb.UpdateProfileRequest{
+			Profile:    p,
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: paths},
+		})
+	}
+
+	t.Run("Get", func(t *testing.T) {
+		p, err := svc.GetMyProfile(ctx, &pb.Empty{})
+		assert.NoError(t, err)
+		assert.Equal(t, acc.ID.Hex(), p.GetId())
+		assert.Equal(t, "isaiah@example.com", p.GetEmail())
+		assert.True(t, p.GetEmailVerified())
+		assert.Equal(t, "Isaiah Wong", p.GetName())
+		assert.Equal(t, "en", p.GetPreferences().GetLanguage())
+
+		_, err = svc.GetMyProfile(incomingContext(XForwardedFor, "127.0.0.1"), &pb.Empty{})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("Malformed", func(t *testing.T) {
+		for _, tt := range []struct {
+			profile *pb.Profile
+			paths   []string
+		}{
+			{&pb.Profile{FirstName: "Jo"}, nil},
+			{&pb.Profile{Email: "new@example.com"}, []string{"email"}},
+			{&pb.Profile{FirstName: "<b></b>"}, []string{"first_name"}},
+			{&pb.Profile{Picture: "javascript:alert(1)"}, []string{"picture"}},
+			{&pb.Profile{Picture: "https://example.com/<script>"}, []string{"picture"}},
+			{&pb.Profile{Preferences: &pb.Preferences{Language: "not a language"}}, []string{"preferences.language"}},
+		} {
+			_, err := update(tt.profile, tt.paths...)
+			assert.Equal(t, codes.InvalidArgument, status.Code(err), tt.paths)
+		}
+		assert.Empty(t, sets)
+	})
+
+	t.Run("Names", func(t *testing.T) {
+		sets = nil
+		p, err := update(&pb.Profile{FirstName: " <b>Jo</b> ", LastName: "Ignored"}, "first_name")
+		assert.NoError(t, err)
+		assert.Equal(t, "Jo", p.GetFirstName())
+		assert.Equal(t, "Wong", p.GetLastName())
+		assert.Equal(t, "Jo Wong", p.GetName())
+		assert.Equal(t, "Jo", sets[0]["auth.first_name"])
+		assert.Equal(t, "Jo Wong", sets[0]["auth.name"])
+		assert.NotContains(t, sets[0], "auth.last_name")
+	})
+
+	t.Run("Picture", func(t *testing.T) {
+		sets = nil
+		url := "https://example.com/a.png?w=64&h=64"
+		p, err := update(&pb.Profile{Picture: url}, "picture")
+		assert.NoError(t, err)
+		assert.Equal(t, url, p.GetPicture())
+		assert.Equal(t, url, sets[0]["auth.picture"])
+		assert.NotContains(t, sets[0], "auth.name")
+	})
+
+	t.Run("Preferences", func(t *testing.T) {
+		sets = nil
+		prefs := &pb.Preferences{
+			Language:          "zh-hant-tw",
+			PushNotifications: &pb.Preferences_PushNotifications{UnsubscribeFromAll: true},
+		}
+		p, err := update(&pb.Profile{Preferences: prefs}, "preferences.push_notifications")
+		assert.NoError(t, err)
+		assert.Equal(t, "en", p.GetPreferences().GetLanguage())
+		assert.True(t, p.GetPreferences().GetPushNotifications().GetUnsubscribeFromAll())
+		assert.Equal(t, models.PushNotifications{UnsubscribeFromAll: true}, sets[0]["preferences.push_notification"])
+
+		// The parent takes the whole message
+		p, err = update(&pb.Profile{Preferences: prefs}, "preferences", "preferences.language")
+		assert.NoError(t, err)
+		assert.Equal(t, "zh-Hant-TW", p.GetPreferences().GetLanguage())
+		assert.Equal(t, models.Preferences{
+			Language:          "zh-Hant-TW",
+			PushNotifications: models.PushNotifications{UnsubscribeFromAll: true},
+		}, sets[1]["preferences"])
+		assert.NotContains(t, sets[1], "preferences.language")
+	})
+}
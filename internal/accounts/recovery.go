@@ -0,0 +1,219 @@
+package accounts
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base32"
+	"fmt"
+	"strings"
+	"time"
+
+	accountsV1 "github.com/isaiahwong/accounts-go/api/accounts/v1"
+	"github.com/isaiahwong/accounts-go/internal/common"
+	"github.com/isaiahwong/accounts-go/internal/common/totp"
+	"github.com/isaiahwong/accounts-go/internal/common/validator"
+	"github.com/isaiahwong/accounts-go/internal/models"
+	"github.com/isaiahwong/accounts-go/internal/oauth"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// recoveryCodeCount defines the number of recovery codes issued in a set
+const recoveryCodeCount = 10
+
+// VerifyRecoveryCode is a gRPC handler that completes a login challenge
+// deferred for a second factor using one of the account's recovery codes
+func (s *Service) VerifyRecoveryCode(ctx context.Context, req *accountsV1.VerifyRecoveryCodeRequest) (*accountsV1.RedirectResponse, error) {
+	api := "VerifyRecoveryCode: "
+
+	ip := common.GetMetadataValue(ctx, XForwardedFor)
+	challenge := common.GetMetadataValue(ctx, LoginChallenge)
+	code := normalizeRecoveryCode(req.GetCode())
+
+	errs := validator.Val(
+		s.validate,
+		validator.Field{
+			Param:          "code",
+			Message:        "Invalid code",
+			Value:          code,
+			Tag:            "required,alphanum,max=32",
+			OmitParamValue: true,
+		},
+		validator.Field{
+			Param:   LoginChallenge,
+			Message: LoginChallenge + " header required",
+			Value:   challenge,
+			Tag:     `required`,
+		},
+		validator.Field{
+			Param:   XForwardedFor,
+			Message: XForwardedFor + " header required",
+			Value:   ip,
+			Tag:     `required`,
+		},
+	)
+	// Validate
+	if len(errs) > 0 {
+		return nil, s.returnErrors(ctx, errs, codes.InvalidArgument, "Malformed request", api)
+	}
+	// Prepend IP for logging
+	api = fmt.Sprintf("[%v] %v", ip, api)
+
+	u, err := s.findPendingLogin(challenge)
+	if err != nil {
+		s.logger.Errorf("%v: %v", api, err)
+		return nil, status.Error(codes.Internal, "An Internal error has occurred")
+	}
+	if u == nil || !u.MFA.Enabled || u.PendingLogin.Attempts >= maxMFAAttempts {
+		return nil, status.Error(codes.PermissionDenied, "Login session has expired. Please sign in again")
+	}
+
+	digest := hashToken(code)
+	found := false
+	for _, rc := range u.MFA.RecoveryCodes {
+		if !rc.Used && subtle.ConstantTimeCompare([]byte(rc.Hash), []byte(digest)) == 1 {
+			found = true
+		}
+	}
+	if !found {
+		return nil, s.rejectMFACode(ctx, u, api)
+	}
+
+	// Mark the code used. The filter guards against concurrent redemption.
+	_, err = s.accountsRepo.Update(
+		nil,
+		bson.M{
+			"_id": u.ID,
+			"mfa.recovery_codes": bson.M{
+				"$elemMatch": bson.M{"hash": digest, "used": false},
+			},
+		},
+		bson.M{
+			"$set": bson.M{
+				"updated_at":                   time.Now(),
+				"mfa.recovery_codes.$.used":    true,
+				"mfa.recovery_codes.$.used_at": time.Now(),
+				"mfa.recovery_codes.$.used_ip": ip,
+			},
+		},
+	)
+	if err == mongo.ErrNoDocuments {
+		return nil, s.rejectMFACode(ctx, u, api)
+	}
+	if err != nil {
+		s.logger.Errorf("%v: %v", api, err)
+		return nil, status.Error(codes.Internal, "An Internal error has occurred")
+	}
+
+	amr := append(u.PendingLogin.AMR, oauth.AMROTP, oauth.AMRMFA)
+	r, err := s.acceptLogin(challenge, u, oauth.ACRMultiFactor, amr...)
+	if err != nil {
+		s.logger.Errorf("%v: acceptLogin: %v", api, err)
+		// Cast  to hydra error
+		if he, ok := err.(*oauth.HydraError); ok {
+			return nil, s.returnHydraError(ctx, he, api)
+		}
+		return nil, status.Error(codes.Internal, "An Internal error has occurred")
+	}
+
+	return &accountsV1.RedirectResponse{RedirectTo: r.RedirectTo}, nil
+}
+
+// GenerateRecoveryCodes is a gRPC handler that issues the first set of
+// recovery codes once two-factor authentication is enabled. Codes are only
+// returned in this response.
+func (s *Service) GenerateRecoveryCodes(ctx context.Context, _ *accountsV1.Empty) (*accountsV1.RecoveryCodesResponse, error) {
+	api := "GenerateRecoveryCodes: "
+
+	u, err := s.bearerRequest(ctx, &api)
+	if err != nil {
+		return nil, err
+	}
+	if !u.MFA.Enabled {
+		return nil, status.Error(codes.FailedPrecondition, "Two-factor authentication is not enabled")
+	}
+	if len(u.MFA.RecoveryCodes) > 0 {
+		return nil, status.Error(codes.FailedPrecondition, "Recovery codes have already been generated")
+	}
+
+	return s.issueRecoveryCodes(u, api)
+}
+
+// RegenerateRecoveryCodes is a gRPC handler that replaces the account's
+// recovery codes. A current TOTP code is required.
+func (s *Service) RegenerateRecoveryCodes(ctx context.Context, req *accountsV1.TOTPRequest) (*accountsV1.RecoveryCodesResponse, error) {
+	api := "RegenerateRecoveryCodes: "
+
+	u, code, err := s.totpRequest(ctx, req, &api)
+	if err != nil {
+		return nil, err
+	}
+	if !u.MFA.Enabled {
+		return nil, status.Error(codes.FailedPrecondition, "Two-factor authentication is not enabled")
+	}
+
+	if _, ok := totp.Validate(u.MFA.TOTPSecret, code, time.Now()); !ok {
+		return nil, s.returnErrors(ctx, []validator.Error{
+			{
+				Param:   "code",
+				Message: "Invalid code",
+			},
+		}, codes.InvalidArgument, "Invalid code", api)
+	}
+
+	return s.issueRecoveryCodes(u, api)
+}
+
+// issueRecoveryCodes replaces the account's recovery codes with a new set
+// and returns the codes in plain text
+func (s *Service) issueRecoveryCodes(u *models.Account, prefix string) (*accountsV1.RecoveryCodesResponse, error) {
+	plain := make([]string, 0, recoveryCodeCount)
+	stored := make([]models.RecoveryCode, 0, recoveryCodeCount)
+	for i := 0; i < recoveryCodeCount; i++ {
+		code, err := generateRecoveryCode()
+		if err != nil {
+			s.logger.Errorf("%v: %v", prefix, err)
+			return nil, status.Error(codes.Internal, "An Internal error has occurred")
+		}
+		plain = append(plain, code)
+		stored = append(stored, models.RecoveryCode{Hash: hashToken(normalizeRecoveryCode(code))})
+	}
+
+	_, err := s.accountsRepo.Update(
+		nil,
+		bson.M{"_id": u.ID},
+		bson.M{
+			"$set": bson.M{
+				"updated_at":                time.Now(),
+				"mfa.recovery_codes":        stored,
+				"mfa.recovery_codes_issued": time.Now(),
+			},
+		},
+	)
+	if err != nil {
+		s.logger.Errorf("%v: %v", prefix, err)
+		return nil, status.Error(codes.Internal, "An Internal error has occurred")
+	}
+
+	return &accountsV1.RecoveryCodesResponse{Codes: plain}, nil
+}
+
+// generateRecoveryCode returns a random code of the form xxxxx-xxxxx
+func generateRecoveryCode() (string, error) {
+	b := make([]byte, 6)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	c := strings.ToLower(base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString(b))[:10]
+	return c[:5] + "-" + c[5:], nil
+}
+
+// normalizeRecoveryCode strips separators and case so codes can be typed
+// loosely
+func normalizeRecoveryCode(code string) string {
+	code = strings.ToLower(code)
+	return strings.NewReplacer("-", "", " ", "").Replace(code)
+}
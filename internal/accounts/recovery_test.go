@@ -0,0 +1,127 @@
+package accounts
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	pb "github.com/isaiahwong/accounts-go/api/accounts/v1"
+	"github.com/isaiahwong/accounts-go/internal/common/totp"
+	"github.com/isaiahwong/accounts-go/internal/models"
+	"github.com/isaiahwong/accounts-go/internal/oauth"
+	"github.com/isaiahwong/accounts-go/tests/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestRecoveryCodes(t *testing.T) {
+	secret, _ := totp.GenerateSecret()
+	acc := &models.Account{
+		ID:   primitive.NewObjectID(),
+		Auth: models.Auth{Email: "isaiah@example.com"},
+		MFA: models.MFA{
+			Enabled:    true,
+			TOTPSecret: secret,
+		},
+	}
+
+	var accepted *oauth.HydraLoginAccept
+	hydra, srv := setupHydra(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPut {
+			accepted = &oauth.HydraLoginAccept{}
+			json.NewDecoder(r.Body).Decode(accepted)
+			json.NewEncoder(w).Encode(oauth.HydraRedirect{RedirectTo: "https://example.com/callback"})
+			return
+		}
+		json.NewEncoder(w).Encode(oauth.InstrospectResponse{Active: true, Sub: acc.ID.Hex()})
+	})
+	defer srv.Close()
+
+	// Apply recovery code updates to acc
+	repo := new(mocks.Repo)
+	repo.On("GetTimeout").Return(time.Second)
+	repo.On("FindOne", mock.Anything, mock.Anything).Return(acc, nil)
+	repo.On("Update", nil, mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		set, _ := args.Get(2).(bson.M)["$set"].(bson.M)
+		if v, ok := set["mfa.recovery_codes"].([]models.RecoveryCode); ok {
+			acc.MFA.RecoveryCodes = v
+		}
+		if _, ok := set["mfa.recovery_codes.$.used"]; ok {
+			match := args.Get(1).(bson.M)["mfa.recovery_codes"].(bson.M)["$elemMatch"].(bson.M)
+			for i := range acc.MFA.RecoveryCodes {
+				if acc.MFA.RecoveryCodes[i].Hash == match["hash"] {
+					acc.MFA.RecoveryCodes[i].Used = true
+				}
+			}
+		}
+	}).Return(1, nil)
+
+	svc := &Service{
+		logger:       logger,
+		accountsRepo: repo,
+		oAuthClient:  hydra,
+	}
+	svc.initValidator()
+
+	var generated []string
+	t.Run("Generate", func(t *testing.T) {
+		ctx := incomingContext(
+			XForwardedFor, "127.0.0.1",
+			Authorization, "Bearer token",
+		)
+		resp, err := svc.GenerateRecoveryCodes(ctx, &pb.Empty{})
+		assert.NoError(t, err)
+		generated = resp.GetCodes()
+		assert.Len(t, generated, recoveryCodeCount)
+		assert.Len(t, acc.MFA.RecoveryCodes, recoveryCodeCount)
+		for i, rc := range acc.MFA.RecoveryCodes {
+			assert.NotContains(t, rc.Hash, normalizeRecoveryCode(generated[i]), "codes should be stored hashed")
+		}
+
+		_, err = svc.GenerateRecoveryCodes(ctx, &pb.Empty{})
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	})
+
+	ctx := incomingContext(
+		XForwardedFor, "127.0.0.1",
+		LoginChallenge, "challenge",
+	)
+
+	t.Run("Wrong code", func(t *testing.T) {
+		_, err := svc.VerifyRecoveryCode(ctx, &pb.VerifyRecoveryCodeRequest{Code: "aaaaa-aaaaa"})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+		assert.Nil(t, accepted, "login should not be accepted")
+	})
+
+	t.Run("Valid code", func(t *testing.T) {
+		resp, err := svc.VerifyRecoveryCode(ctx, &pb.VerifyRecoveryCodeRequest{Code: strings.ToUpper(generated[0])})
+		assert.NoError(t, err)
+		assert.Equal(t, "https://example.com/callback", resp.GetRedirectTo())
+		assert.Equal(t, oauth.ACRMultiFactor, accepted.Acr)
+		assert.True(t, acc.MFA.RecoveryCodes[0].Used)
+	})
+
+	t.Run("Used code", func(t *testing.T) {
+		_, err := svc.VerifyRecoveryCode(ctx, &pb.VerifyRecoveryCodeRequest{Code: generated[0]})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+
+	t.Run("Regenerate", func(t *testing.T) {
+		ctx := incomingContext(
+			XForwardedFor, "127.0.0.1",
+			Authorization, "Bearer token",
+		)
+		code, _ := totp.Code(secret, totp.Step(time.Now()))
+		resp, err := svc.RegenerateRecoveryCodes(ctx, &pb.TOTPRequest{Code: code})
+		assert.NoError(t, err)
+		assert.Len(t, resp.GetCodes(), recoveryCodeCount)
+		assert.NotEqual(t, generated[1], resp.GetCodes()[1])
+		assert.False(t, acc.MFA.RecoveryCodes[0].Used)
+	})
+}
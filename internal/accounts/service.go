@@ -1,22 +1,42 @@
 package accounts
 
 import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
 	"errors"
+	"fmt"
+	"io/ioutil"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/go-playground/validator/v10"
 	accountsV1 "github.com/isaiahwong/accounts-go/api/accounts/v1"
 	"github.com/isaiahwong/accounts-go/api/client"
 	mailV1 "github.com/isaiahwong/accounts-go/api/mail/v1"
+	"github.com/isaiahwong/accounts-go/internal/breach"
 	"github.com/isaiahwong/accounts-go/internal/common"
 	"github.com/isaiahwong/accounts-go/internal/common/email"
 	"github.com/isaiahwong/accounts-go/internal/common/log"
+	"github.com/isaiahwong/accounts-go/internal/common/password"
+	"github.com/isaiahwong/accounts-go/internal/connectors"
+	"github.com/isaiahwong/accounts-go/internal/facebook"
 	"github.com/isaiahwong/accounts-go/internal/oauth"
+	"github.com/isaiahwong/accounts-go/internal/oidc"
+	"github.com/isaiahwong/accounts-go/internal/sms"
 	"github.com/isaiahwong/accounts-go/internal/store"
 	"github.com/isaiahwong/accounts-go/internal/store/drivers/mongo"
 	repo "github.com/isaiahwong/accounts-go/internal/store/repo/accounts"
+	"github.com/isaiahwong/accounts-go/internal/webauthn"
 	"github.com/microcosm-cc/bluemonday"
 )
 
+// SMSSender delivers text messages to phone numbers in E.164 format
+type SMSSender interface {
+	SendSMS(ctx context.Context, to, body string) error
+}
+
 // Service defines the logic for authentication
 type Service struct {
 	production      bool
@@ -26,9 +46,26 @@ type Service struct {
 	validate        *validator.Validate
 	recaptchaURL    string
 	recaptchaSecret string
-	accountsRepo    repo.Repo
-	oAuthClient     *oauth.Hydra
-	mailSVC         mailV1.MailServiceClient
+	totpIssuer      string
+	webAuthn        *webauthn.WebAuthn
+	// webAuthnDecoyKey derives the decoy credential IDs offered for
+	// accounts without passkeys
+	webAuthnDecoyKey []byte
+	federationKey    []byte
+	google           *oidc.Provider
+	facebook         *facebook.Client
+	connectors       *connectors.Registry
+	sms              SMSSender
+	lockout          lockoutPolicy
+	hashers          *password.Registry
+	passwordPolicy   password.Policy
+	passwordHistory  int
+	passwordMaxAge   time.Duration
+	breached         breach.Checker
+	adminScope       string
+	accountsRepo     repo.Repo
+	oAuthClient      *oauth.Hydra
+	mailSVC          mailV1.MailServiceClient
 }
 
 func (svc *Service) initRepoWithMongo(s store.DataStore) error {
@@ -65,6 +102,216 @@ func (svc *Service) initServices() error {
 	return nil
 }
 
+// initFederation configures upstream identity providers. Providers without
+// a client id are disabled.
+func (svc *Service) initFederation() error {
+	key := common.MapEnvWithDefaults("FEDERATION_STATE_KEY", "")
+	if key == "" {
+		// State will not verify across replicas or restarts
+		svc.logger.Warn("auth: FEDERATION_STATE_KEY is not set, using a random key")
+		b := make([]byte, 32)
+		if _, err := rand.Read(b); err != nil {
+			return err
+		}
+		key = string(b)
+	}
+	svc.federationKey = []byte(key)
+
+	if id := common.MapEnvWithDefaults("GOOGLE_CLIENT_ID", ""); id != "" {
+		svc.google = oidc.New(oidc.Config{
+			Issuer:       common.MapEnvWithDefaults("GOOGLE_ISSUER", "https://accounts.google.com"),
+			ClientID:     id,
+			ClientSecret: common.MapEnvWithDefaults("GOOGLE_CLIENT_SECRET", ""),
+			RedirectURL:  common.MapEnvWithDefaults("GOOGLE_REDIRECT_URL", ""),
+			AuthURL:      common.MapEnvWithDefaults("GOOGLE_AUTH_URL", "https://accounts.google.com/o/oauth2/v2/auth"),
+			TokenURL:     common.MapEnvWithDefaults("GOOGLE_TOKEN_URL", "https://oauth2.googleapis.com/token"),
+			JWKSURL:      common.MapEnvWithDefaults("GOOGLE_JWKS_URL", "https://www.googleapis.com/oauth2/v3/certs"),
+		})
+	}
+	if id := common.MapEnvWithDefaults("FACEBOOK_CLIENT_ID", ""); id != "" {
+		svc.facebook = facebook.New(facebook.Config{
+			ClientID:     id,
+			ClientSecret: common.MapEnvWithDefaults("FACEBOOK_CLIENT_SECRET", ""),
+			RedirectURL:  common.MapEnvWithDefaults("FACEBOOK_REDIRECT_URL", ""),
+			AuthURL:      common.MapEnvWithDefaults("FACEBOOK_AUTH_URL", ""),
+			GraphURL:     common.MapEnvWithDefaults("FACEBOOK_GRAPH_URL", ""),
+		})
+	}
+
+	// OpenID Connect connectors are configured as a JSON list, inline or
+	// from a file
+	raw := []byte(common.MapEnvWithDefaults("OIDC_CONNECTORS", ""))
+	if f := common.MapEnvWithDefaults("OIDC_CONNECTORS_FILE", ""); f != "" {
+		b, err := ioutil.ReadFile(f)
+		if err != nil {
+			return err
+		}
+		raw = b
+	}
+	if len(raw) > 0 {
+		configs, err := connectors.Parse(raw)
+		if err != nil {
+			return err
+		}
+		svc.connectors, err = connectors.NewRegistry(configs)
+		if err != nil {
+			return err
+		}
+		svc.logger.Infof("auth: OpenID Connect connectors %v", svc.connectors.IDs())
+	}
+	return nil
+}
+
+// initWebAuthn configures the key decoy passkeys for unknown accounts are
+// derived from. WEBAUTHN_DECOY_KEY should be shared by every replica.
+func (svc *Service) initWebAuthn() error {
+	key := common.MapEnvWithDefaults("WEBAUTHN_DECOY_KEY", "")
+	if key == "" {
+		// Decoy credentials will differ across replicas and restarts
+		svc.logger.Warn("auth: WEBAUTHN_DECOY_KEY is not set, using a random key")
+		b := make([]byte, 32)
+		if _, err := rand.Read(b); err != nil {
+			return err
+		}
+		key = string(b)
+	}
+	svc.webAuthnDecoyKey = []byte(key)
+	return nil
+}
+
+// initSMS configures the SMS sender. SMS_SENDER selects "log" or "file",
+// the latter appending to SMS_FILE.
+func (svc *Service) initSMS() error {
+	switch sender := common.MapEnvWithDefaults("SMS_SENDER", "log"); sender {
+	case "log":
+		svc.sms = sms.NewLogSender(svc.logger)
+	case "file":
+		svc.sms = sms.NewFileSender(common.MapEnvWithDefaults("SMS_FILE", "sms.log"))
+	default:
+		return fmt.Errorf("auth: unsupported SMS_SENDER %q", sender)
+	}
+	return nil
+}
+
+// initLockout configures when failed password attempts lock accounts.
+// Setting LOCKOUT_THRESHOLD to 0 disables locking.
+func (svc *Service) initLockout() error {
+	threshold, err := strconv.Atoi(common.MapEnvWithDefaults("LOCKOUT_THRESHOLD", "5"))
+	if err != nil {
+		return fmt.Errorf("auth: LOCKOUT_THRESHOLD: %v", err)
+	}
+	duration, err := time.ParseDuration(common.MapEnvWithDefaults("LOCKOUT_DURATION", "15m"))
+	if err != nil {
+		return fmt.Errorf("auth: LOCKOUT_DURATION: %v", err)
+	}
+	maxDuration, err := time.ParseDuration(common.MapEnvWithDefaults("LOCKOUT_MAX_DURATION", "24h"))
+	if err != nil {
+		return fmt.Errorf("auth: LOCKOUT_MAX_DURATION: %v", err)
+	}
+	svc.lockout = lockoutPolicy{
+		threshold:   threshold,
+		duration:    duration,
+		maxDuration: maxDuration,
+	}
+	return nil
+}
+
+// initHashers configures password hashing. New hashes are created with
+// PASSWORD_HASHER. Hashes imported from Firebase verify once the project's
+// FIREBASE_SIGNER_KEY and FIREBASE_SALT_SEPARATOR are set.
+func (svc *Service) initHashers() error {
+	legacy := []password.Verifier{
+		password.PBKDF2{Digest: "sha1"},
+		password.PBKDF2{Digest: "sha256"},
+		password.PBKDF2{Digest: "sha512"},
+		password.SHACrypt{},
+	}
+	if key := common.MapEnvWithDefaults("FIREBASE_SIGNER_KEY", ""); key != "" {
+		signerKey, err := base64.StdEncoding.DecodeString(key)
+		if err != nil {
+			return fmt.Errorf("auth: FIREBASE_SIGNER_KEY: %v", err)
+		}
+		separator, err := base64.StdEncoding.DecodeString(common.MapEnvWithDefaults("FIREBASE_SALT_SEPARATOR", ""))
+		if err != nil {
+			return fmt.Errorf("auth: FIREBASE_SALT_SEPARATOR: %v", err)
+		}
+		legacy = append(legacy, password.FirebaseScrypt{SignerKey: signerKey, SaltSeparator: separator})
+	}
+	hashers, err := password.New(common.MapEnvWithDefaults("PASSWORD_HASHER", "argon2id"), legacy...)
+	if err != nil {
+		return err
+	}
+	svc.hashers = hashers
+	return nil
+}
+
+// initBreach loads the breached password corpus at BREACH_CORPUS, a bloom
+// filter or sorted SHA-1 file per BREACH_CORPUS_FORMAT. Passwords are not
+// screened without one.
+func (svc *Service) initBreach() error {
+	path := common.MapEnvWithDefaults("BREACH_CORPUS", "")
+	if path == "" {
+		return nil
+	}
+	c, err := breach.Open(path, common.MapEnvWithDefaults("BREACH_CORPUS_FORMAT", "bloom"))
+	if err != nil {
+		return err
+	}
+	svc.breached = c
+	svc.logger.Infof("auth: screening passwords against %v", path)
+	return nil
+}
+
+// initPasswordPolicy loads the password policy, a JSON object given inline
+// in PASSWORD_POLICY or read from PASSWORD_POLICY_FILE. Rules left out keep
+// their defaults.
+func (svc *Service) initPasswordPolicy() error {
+	svc.passwordPolicy = password.DefaultPolicy()
+	raw := []byte(common.MapEnvWithDefaults("PASSWORD_POLICY", ""))
+	if f := common.MapEnvWithDefaults("PASSWORD_POLICY_FILE", ""); f != "" {
+		b, err := ioutil.ReadFile(f)
+		if err != nil {
+			return err
+		}
+		raw = b
+	}
+	if len(raw) == 0 {
+		return nil
+	}
+	p, err := password.ParsePolicy(raw)
+	if err != nil {
+		return err
+	}
+	svc.passwordPolicy = p
+	return nil
+}
+
+// initPasswordHistory reads PASSWORD_HISTORY, the number of recent
+// passwords which may not be reused including the current one. Zero allows
+// reuse.
+func (svc *Service) initPasswordHistory() error {
+	n, err := strconv.Atoi(common.MapEnvWithDefaults("PASSWORD_HISTORY", "5"))
+	if err != nil {
+		return fmt.Errorf("auth: PASSWORD_HISTORY: %v", err)
+	}
+	if n < 0 {
+		return errors.New("auth: PASSWORD_HISTORY must not be negative")
+	}
+	svc.passwordHistory = n
+	return nil
+}
+
+// initPasswordExpiry reads PASSWORD_MAX_AGE, how long a password may be used
+// before it must be changed. Zero never expires passwords.
+func (svc *Service) initPasswordExpiry() error {
+	age, err := time.ParseDuration(common.MapEnvWithDefaults("PASSWORD_MAX_AGE", "0"))
+	if err != nil {
+		return fmt.Errorf("auth: PASSWORD_MAX_AGE: %v", err)
+	}
+	svc.passwordMaxAge = age
+	return nil
+}
+
 func initServices() error {
 	return nil
 }
@@ -87,9 +334,43 @@ func RegisterService(opt ...ServiceOption) error {
 		logger:      opts.logger,
 		policy:      bluemonday.StrictPolicy(),
 		oAuthClient: oauth.NewHydraClient(),
+		totpIssuer:  common.MapEnvWithDefaults("TOTP_ISSUER", "Accounts"),
+		adminScope:  common.MapEnvWithDefaults("ADMIN_SCOPE", "accounts.admin"),
+		webAuthn: webauthn.New(webauthn.Config{
+			RPID:    common.MapEnvWithDefaults("WEBAUTHN_RP_ID", "localhost"),
+			RPName:  common.MapEnvWithDefaults("WEBAUTHN_RP_NAME", "Accounts"),
+			Origins: strings.Split(common.MapEnvWithDefaults("WEBAUTHN_ORIGINS", "http://localhost:3000"), ","),
+		}),
 	}
 	svc.initValidator()
 	svc.initServices()
+	if err := svc.initFederation(); err != nil {
+		return err
+	}
+	if err := svc.initWebAuthn(); err != nil {
+		return err
+	}
+	if err := svc.initSMS(); err != nil {
+		return err
+	}
+	if err := svc.initLockout(); err != nil {
+		return err
+	}
+	if err := svc.initHashers(); err != nil {
+		return err
+	}
+	if err := svc.initBreach(); err != nil {
+		return err
+	}
+	if err := svc.initPasswordPolicy(); err != nil {
+		return err
+	}
+	if err := svc.initPasswordHistory(); err != nil {
+		return err
+	}
+	if err := svc.initPasswordExpiry(); err != nil {
+		return err
+	}
 
 	// Initializes repositories
 	if err := svc.initRepoWithMongo(opts.store); err != nil {
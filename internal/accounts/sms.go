@@ -0,0 +1,378 @@
+package accounts
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	accountsV1 "github.com/isaiahwong/accounts-go/api/accounts/v1"
+	"github.com/isaiahwong/accounts-go/internal/common"
+	"github.com/isaiahwong/accounts-go/internal/common/validator"
+	"github.com/isaiahwong/accounts-go/internal/models"
+	"github.com/isaiahwong/accounts-go/internal/oauth"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// smsCodeExpiry defines how long an SMS code is valid for
+const smsCodeExpiry = 5 * time.Minute
+
+// smsResendInterval defines the minimum duration between SMS codes sent to
+// an account
+const smsResendInterval = 30 * time.Second
+
+// smsCodeAttempts defines how many times a phone verification code may be
+// guessed
+const smsCodeAttempts = 5
+
+// smsCodeDigits defines the length of SMS codes
+const smsCodeDigits = 6
+
+// AddPhoneNumber is a gRPC handler that texts a verification code to a new
+// phone number for the authenticated account. The number replaces the
+// current one once verified through VerifyPhoneNumber.
+func (s *Service) AddPhoneNumber(ctx context.Context, req *accountsV1.PhoneNumberRequest) (*accountsV1.Empty, error) {
+	api := "AddPhoneNumber: "
+
+	number := strings.TrimSpace(req.GetPhoneNumber())
+
+	errs := validator.Val(
+		s.validate,
+		validator.Field{
+			Param:   "phone_number",
+			Message: "Invalid phone number",
+			Value:   number,
+			Tag:     "required,e164",
+		},
+	)
+	u, err := s.bearerRequest(ctx, &api, errs...)
+	if err != nil {
+		return nil, err
+	}
+	// Otherwise a stolen token could move the second factor to another phone
+	if u.Phone.MFA {
+		return nil, status.Error(codes.FailedPrecondition, "Disable SMS two-factor authentication before changing the phone number")
+	}
+
+	if err := s.sendSMSCode(ctx, u, number, number, bson.M{"phone.pending": number}, api); err != nil {
+		return nil, err
+	}
+	return &accountsV1.Empty{}, nil
+}
+
+// VerifyPhoneNumber is a gRPC handler that sets the pending phone number as
+// the account's verified number given the code texted to it
+func (s *Service) VerifyPhoneNumber(ctx context.Context, req *accountsV1.SMSCodeRequest) (*accountsV1.Empty, error) {
+	api := "VerifyPhoneNumber: "
+
+	code := strings.TrimSpace(req.GetCode())
+
+	errs := validator.Val(
+		s.validate,
+		validator.Field{
+			Param:          "code",
+			Message:        "Invalid code",
+			Value:          code,
+			Tag:            fmt.Sprintf("required,numeric,len=%d", smsCodeDigits),
+			OmitParamValue: true,
+		},
+	)
+	u, err := s.bearerRequest(ctx, &api, errs...)
+	if err != nil {
+		return nil, err
+	}
+	if u.Phone.Pending == "" {
+		return nil, status.Error(codes.FailedPrecondition, "No phone number pending verification")
+	}
+
+	invalidCode := []validator.Error{
+		{
+			Param:   "code",
+			Message: "Code is invalid or has expired",
+		},
+	}
+	if time.Now().After(u.Phone.CodeExpires) {
+		return nil, s.returnErrors(ctx, invalidCode, codes.InvalidArgument, "Invalid code", api)
+	}
+
+	// Claim an attempt before comparing so concurrent guesses cannot exceed
+	// the limit
+	_, err = s.accountsRepo.Update(
+		nil,
+		bson.M{
+			"_id":            u.ID,
+			"phone.code":     u.Phone.Code,
+			"phone.attempts": bson.M{"$lt": smsCodeAttempts},
+		},
+		bson.M{
+			"$set": bson.M{"updated_at": time.Now()},
+			"$inc": bson.M{"phone.attempts": 1},
+		},
+	)
+	if err == mongo.ErrNoDocuments {
+		return nil, s.returnErrors(ctx, invalidCode, codes.InvalidArgument, "Invalid code", api)
+	}
+	if err != nil {
+		s.logger.Errorf("%v: %v", api, err)
+		return nil, status.Error(codes.Internal, "An Internal error has occurred")
+	}
+	if !compareToken(u.Phone.Pending+code, u.Phone.Code) {
+		return nil, s.returnErrors(ctx, invalidCode, codes.InvalidArgument, "Invalid code", api)
+	}
+
+	// Match on the code digest so the code can only be used once
+	_, err = s.accountsRepo.Update(
+		nil,
+		bson.M{
+			"_id":        u.ID,
+			"phone.code": u.Phone.Code,
+		},
+		bson.M{
+			"$set": bson.M{
+				"updated_at":          time.Now(),
+				"phone.number":        u.Phone.Pending,
+				"phone.verified":      true,
+				"phone.verified_date": time.Now(),
+				"phone.pending":       "",
+				"phone.code":          "",
+				"phone.code_expires":  time.Time{},
+				"phone.attempts":      0,
+			},
+		},
+	)
+	if err == mongo.ErrNoDocuments {
+		return nil, s.returnErrors(ctx, invalidCode, codes.InvalidArgument, "Invalid code", api)
+	}
+	if err != nil {
+		s.logger.Errorf("%v: %v", api, err)
+		return nil, status.Error(codes.Internal, "An Internal error has occurred")
+	}
+
+	return &accountsV1.Empty{}, nil
+}
+
+// EnableSMSMFA is a gRPC handler that enables the account's verified phone
+// number as a second factor
+func (s *Service) EnableSMSMFA(ctx context.Context, _ *accountsV1.Empty) (*accountsV1.Empty, error) {
+	api := "EnableSMSMFA: "
+
+	u, err := s.bearerRequest(ctx, &api)
+	if err != nil {
+		return nil, err
+	}
+	if !u.Phone.Verified || u.Phone.Number == "" {
+		return nil, status.Error(codes.FailedPrecondition, "A verified phone number is required")
+	}
+	if u.Phone.MFA {
+		return nil, status.Error(codes.FailedPrecondition, "SMS two-factor authentication is already enabled")
+	}
+
+	if err := s.setSMSMFA(u, true, api); err != nil {
+		return nil, err
+	}
+	return &accountsV1.Empty{}, nil
+}
+
+// DisableSMSMFA is a gRPC handler that stops using the account's phone
+// number as a second factor
+func (s *Service) DisableSMSMFA(ctx context.Context, _ *accountsV1.Empty) (*accountsV1.Empty, error) {
+	api := "DisableSMSMFA: "
+
+	u, err := s.bearerRequest(ctx, &api)
+	if err != nil {
+		return nil, err
+	}
+	if !u.Phone.MFA {
+		return nil, status.Error(codes.FailedPrecondition, "SMS two-factor authentication is not enabled")
+	}
+
+	if err := s.setSMSMFA(u, false, api); err != nil {
+		return nil, err
+	}
+	return &accountsV1.Empty{}, nil
+}
+
+// SendSMSMFACode is a gRPC handler that texts a second factor code for a
+// login challenge awaiting one
+func (s *Service) SendSMSMFACode(ctx context.Context, _ *accountsV1.Empty) (*accountsV1.Empty, error) {
+	api := "SendSMSMFACode: "
+
+	ip := common.GetMetadataValue(ctx, XForwardedFor)
+	challenge := common.GetMetadataValue(ctx, LoginChallenge)
+
+	errs := validator.Val(
+		s.validate,
+		validator.Field{
+			Param:   LoginChallenge,
+			Message: LoginChallenge + " header required",
+			Value:   challenge,
+			Tag:     `required`,
+		},
+		validator.Field{
+			Param:   XForwardedFor,
+			Message: XForwardedFor + " header required",
+			Value:   ip,
+			Tag:     `required`,
+		},
+	)
+	// Validate
+	if len(errs) > 0 {
+		return nil, s.returnErrors(ctx, errs, codes.InvalidArgument, "Malformed request", api)
+	}
+	// Prepend IP for logging
+	api = fmt.Sprintf("[%v] %v", ip, api)
+
+	u, err := s.findPendingLogin(challenge)
+	if err != nil {
+		s.logger.Errorf("%v: %v", api, err)
+		return nil, status.Error(codes.Internal, "An Internal error has occurred")
+	}
+	if u == nil || !u.Phone.MFA || !u.Phone.Verified || u.PendingLogin.Attempts >= maxMFAAttempts {
+		return nil, status.Error(codes.PermissionDenied, "Login session has expired. Please sign in again")
+	}
+
+	if err := s.sendSMSCode(ctx, u, u.Phone.Number, challenge, bson.M{}, api); err != nil {
+		return nil, err
+	}
+	return &accountsV1.Empty{}, nil
+}
+
+// VerifySMSMFA is a gRPC handler that completes a login challenge awaiting
+// a second factor given the code texted by SendSMSMFACode
+func (s *Service) VerifySMSMFA(ctx context.Context, req *accountsV1.SMSCodeRequest) (*accountsV1.RedirectResponse, error) {
+	api := "VerifySMSMFA: "
+
+	ip := common.GetMetadataValue(ctx, XForwardedFor)
+	challenge := common.GetMetadataValue(ctx, LoginChallenge)
+	code := strings.TrimSpace(req.GetCode())
+
+	errs := validator.Val(
+		s.validate,
+		validator.Field{
+			Param:          "code",
+			Message:        "Invalid code",
+			Value:          code,
+			Tag:            fmt.Sprintf("required,numeric,len=%d", smsCodeDigits),
+			OmitParamValue: true,
+		},
+		validator.Field{
+			Param:   LoginChallenge,
+			Message: LoginChallenge + " header required",
+			Value:   challenge,
+			Tag:     `required`,
+		},
+		validator.Field{
+			Param:   XForwardedFor,
+			Message: XForwardedFor + " header required",
+			Value:   ip,
+			Tag:     `required`,
+		},
+	)
+	// Validate
+	if len(errs) > 0 {
+		return nil, s.returnErrors(ctx, errs, codes.InvalidArgument, "Malformed request", api)
+	}
+	// Prepend IP for logging
+	api = fmt.Sprintf("[%v] %v", ip, api)
+
+	u, err := s.findPendingLogin(challenge)
+	if err != nil {
+		s.logger.Errorf("%v: %v", api, err)
+		return nil, status.Error(codes.Internal, "An Internal error has occurred")
+	}
+	if u == nil || !u.Phone.MFA || !u.Phone.Verified || u.PendingLogin.Attempts >= maxMFAAttempts {
+		return nil, status.Error(codes.PermissionDenied, "Login session has expired. Please sign in again")
+	}
+	if time.Now().After(u.Phone.CodeExpires) || !compareToken(challenge+code, u.Phone.Code) {
+		return nil, s.rejectMFACode(ctx, u, api)
+	}
+
+	// Match on the code digest so the code can only be used once
+	_, err = s.accountsRepo.Update(
+		nil,
+		bson.M{
+			"_id":        u.ID,
+			"phone.code": u.Phone.Code,
+		},
+		bson.M{
+			"$set": bson.M{
+				"updated_at":         time.Now(),
+				"phone.code":         "",
+				"phone.code_expires": time.Time{},
+			},
+		},
+	)
+	if err == mongo.ErrNoDocuments {
+		return nil, s.rejectMFACode(ctx, u, api)
+	}
+	if err != nil {
+		s.logger.Errorf("%v: %v", api, err)
+		return nil, status.Error(codes.Internal, "An Internal error has occurred")
+	}
+
+	amr := append(u.PendingLogin.AMR, oauth.AMRSMS, oauth.AMRMFA)
+	r, err := s.acceptLogin(challenge, u, oauth.ACRMultiFactor, amr...)
+	if err != nil {
+		s.logger.Errorf("%v: acceptLogin: %v", api, err)
+		// Cast  to hydra error
+		if he, ok := err.(*oauth.HydraError); ok {
+			return nil, s.returnHydraError(ctx, he, api)
+		}
+		return nil, status.Error(codes.Internal, "An Internal error has occurred")
+	}
+
+	return &accountsV1.RedirectResponse{RedirectTo: r.RedirectTo}, nil
+}
+
+// sendSMSCode texts a new code to the number and stores its digest, salted
+// with salt, along with set. Errors returned are gRPC status errors.
+func (s *Service) sendSMSCode(ctx context.Context, u *models.Account, to, salt string, set bson.M, prefix string) error {
+	if time.Since(u.Phone.CodeSent) < smsResendInterval {
+		return status.Error(codes.ResourceExhausted, "Please wait before requesting another code")
+	}
+
+	code, err := generateCode(smsCodeDigits)
+	if err != nil {
+		s.logger.Errorf("%v: %v", prefix, err)
+		return status.Error(codes.Internal, "An Internal error has occurred")
+	}
+
+	set["updated_at"] = time.Now()
+	set["phone.code"] = hashToken(salt + code)
+	set["phone.code_expires"] = time.Now().Add(smsCodeExpiry)
+	set["phone.code_sent"] = time.Now()
+	set["phone.attempts"] = 0
+	_, err = s.accountsRepo.Update(nil, bson.M{"_id": u.ID}, bson.M{"$set": set})
+	if err != nil {
+		s.logger.Errorf("%v: %v", prefix, err)
+		return status.Error(codes.Internal, "An Internal error has occurred")
+	}
+
+	if err := s.sms.SendSMS(ctx, to, fmt.Sprintf("Your verification code is %v", code)); err != nil {
+		s.logger.Errorf("%v: SendSMS: %v", prefix, err)
+		return status.Error(codes.Internal, "An Internal error has occurred")
+	}
+	return nil
+}
+
+// setSMSMFA enables or disables the phone number as a second factor
+func (s *Service) setSMSMFA(u *models.Account, enabled bool, prefix string) error {
+	_, err := s.accountsRepo.Update(
+		nil,
+		bson.M{"_id": u.ID},
+		bson.M{
+			"$set": bson.M{
+				"updated_at": time.Now(),
+				"phone.mfa":  enabled,
+			},
+		},
+	)
+	if err != nil {
+		s.logger.Errorf("%v: %v", prefix, err)
+		return status.Error(codes.Internal, "An Internal error has occurred")
+	}
+	return nil
+}
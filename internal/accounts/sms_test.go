@@ -0,0 +1,202 @@
+package accounts
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	pb "github.com/isaiahwong/accounts-go/api/accounts/v1"
+	"github.com/isaiahwong/accounts-go/internal/models"
+	"github.com/isaiahwong/accounts-go/internal/oauth"
+	"github.com/isaiahwong/accounts-go/tests/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"golang.org/x/crypto/bcrypt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// smsStub records text messages instead of sending them
+type smsStub struct {
+	to    []string
+	codes []string
+}
+
+func (s *smsStub) SendSMS(_ context.Context, to, body string) error {
+	s.to = append(s.to, to)
+	s.codes = append(s.codes, body[len(body)-smsCodeDigits:])
+	return nil
+}
+
+func (s *smsStub) last() string {
+	return s.codes[len(s.codes)-1]
+}
+
+func TestSMS(t *testing.T) {
+	hash, _ := bcrypt.GenerateFromPassword([]byte(validPasswords[0]), bcrypt.MinCost)
+	acc := &models.Account{
+		ID: primitive.NewObjectID(),
+		Auth: models.Auth{
+			Email:    "isaiah@example.com",
+			Password: string(hash),
+		},
+	}
+
+	var accepted *oauth.HydraLoginAccept
+	hydra, srv := setupHydra(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPut {
+			accepted = &oauth.HydraLoginAccept{}
+			json.NewDecoder(r.Body).Decode(accepted)
+			json.NewEncoder(w).Encode(oauth.HydraRedirect{RedirectTo: "https://example.com/callback"})
+			return
+		}
+		json.NewEncoder(w).Encode(oauth.InstrospectResponse{Active: true, Sub: acc.ID.Hex()})
+	})
+	defer srv.Close()
+
+	// Apply phone and pending login updates to acc
+	repo := new(mocks.Repo)
+	repo.On("GetTimeout").Return(time.Second)
+	repo.On("FindOne", mock.Anything, mock.Anything).Return(acc, nil)
+	repo.On("Update", nil, mock.Anything, mock.Anything).Return(1, func(_ context.Context, filter interface{}, update interface{}) error {
+		f := filter.(bson.M)
+		if d, ok := f["phone.code"]; ok && d != acc.Phone.Code {
+			return mongo.ErrNoDocuments
+		}
+		if _, ok := f["phone.attempts"]; ok && acc.Phone.Attempts >= smsCodeAttempts {
+			return mongo.ErrNoDocuments
+		}
+		if inc, ok := update.(bson.M)["$inc"].(bson.M); ok {
+			if v, ok := inc["phone.attempts"]; ok {
+				acc.Phone.Attempts += v.(int)
+			}
+			if v, ok := inc["pending_login.attempts"]; ok {
+				acc.PendingLogin.Attempts += v.(int)
+			}
+		}
+		set, _ := update.(bson.M)["$set"].(bson.M)
+		for k, v := range set {
+			switch k {
+			case "phone.number":
+				acc.Phone.Number = v.(string)
+			case "phone.verified":
+				acc.Phone.Verified = v.(bool)
+			case "phone.mfa":
+				acc.Phone.MFA = v.(bool)
+			case "phone.pending":
+				acc.Phone.Pending = v.(string)
+			case "phone.code":
+				acc.Phone.Code = v.(string)
+			case "phone.code_expires":
+				acc.Phone.CodeExpires = v.(time.Time)
+			case "phone.code_sent":
+				acc.Phone.CodeSent = v.(time.Time)
+			case "phone.attempts":
+				acc.Phone.Attempts = v.(int)
+			case "pending_login":
+				acc.PendingLogin = v.(models.PendingLogin)
+			}
+		}
+		return nil
+	})
+
+	texts := &smsStub{}
+	svc := &Service{
+		logger:       logger,
+		accountsRepo: repo,
+		hashers:      testHashers,
+		oAuthClient:  hydra,
+		sms:          texts,
+	}
+	svc.initValidator()
+
+	bearer := incomingContext(
+		XForwardedFor, "127.0.0.1",
+		Authorization, "Bearer token",
+	)
+	login := incomingContext(
+		XForwardedFor, "127.0.0.1",
+		CaptchaResponse, "captcha",
+		LoginChallenge, "challenge",
+	)
+
+	t.Run("Invalid number", func(t *testing.T) {
+		_, err := svc.AddPhoneNumber(bearer, &pb.PhoneNumberRequest{PhoneNumber: "91234567"})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("Verify number", func(t *testing.T) {
+		_, err := svc.AddPhoneNumber(bearer, &pb.PhoneNumberRequest{PhoneNumber: "+6591234567"})
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"+6591234567"}, texts.to)
+		assert.Equal(t, "+6591234567", acc.Phone.Pending)
+
+		_, err = svc.EnableSMSMFA(bearer, &pb.Empty{})
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+		wrong := "000000"
+		if texts.last() == wrong {
+			wrong = "000001"
+		}
+		_, err = svc.VerifyPhoneNumber(bearer, &pb.SMSCodeRequest{Code: wrong})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+		_, err = svc.VerifyPhoneNumber(bearer, &pb.SMSCodeRequest{Code: texts.last()})
+		assert.NoError(t, err)
+		assert.Equal(t, "+6591234567", acc.Phone.Number)
+		assert.True(t, acc.Phone.Verified)
+		assert.Empty(t, acc.Phone.Pending)
+	})
+
+	t.Run("Enable second factor", func(t *testing.T) {
+		_, err := svc.EnableSMSMFA(bearer, &pb.Empty{})
+		assert.NoError(t, err)
+		assert.True(t, acc.Phone.MFA)
+
+		// The number cannot be changed while it is a second factor
+		_, err = svc.AddPhoneNumber(bearer, &pb.PhoneNumberRequest{PhoneNumber: "+6598765432"})
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	})
+
+	t.Run("Authenticate with SMS", func(t *testing.T) {
+		resp, err := svc.Authenticate(login, &pb.AuthenticateRequest{
+			Email:    acc.Auth.Email,
+			Password: validPasswords[0],
+		})
+		assert.NoError(t, err)
+		assert.True(t, resp.GetMfaRequired())
+		assert.Equal(t, []string{"sms"}, resp.GetMfaMethods())
+		assert.Nil(t, accepted, "login should not be accepted")
+
+		acc.Phone.CodeSent = time.Time{}
+		_, err = svc.SendSMSMFACode(login, &pb.Empty{})
+		assert.NoError(t, err)
+		code := texts.last()
+
+		// Codes are rate limited
+		_, err = svc.SendSMSMFACode(login, &pb.Empty{})
+		assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+		wrong := "000000"
+		if code == wrong {
+			wrong = "000001"
+		}
+		_, err = svc.VerifySMSMFA(login, &pb.SMSCodeRequest{Code: wrong})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+		r, err := svc.VerifySMSMFA(login, &pb.SMSCodeRequest{Code: code})
+		assert.NoError(t, err)
+		assert.Equal(t, "https://example.com/callback", r.GetRedirectTo())
+		assert.Equal(t, oauth.ACRMultiFactor, accepted.Acr)
+		assert.Equal(t, []string{oauth.AMRPassword, oauth.AMRSMS, oauth.AMRMFA}, accepted.Amr)
+
+		// Single use
+		_, err = svc.VerifySMSMFA(login, &pb.SMSCodeRequest{Code: code})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+}
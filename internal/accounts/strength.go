@@ -0,0 +1,59 @@
+package accounts
+
+import (
+	"context"
+	"math"
+	"strings"
+
+	accountsV1 "github.com/isaiahwong/accounts-go/api/accounts/v1"
+	"github.com/isaiahwong/accounts-go/internal/common"
+	"github.com/isaiahwong/accounts-go/internal/common/password"
+	"github.com/isaiahwong/accounts-go/internal/common/validator"
+	"google.golang.org/grpc/codes"
+)
+
+// EstimatePasswordStrength is a gRPC handler that estimates how hard a
+// password is to guess for strength meters. The email and names given are
+// treated as the account's, as SignUp does when it enforces the policy's
+// minimum score with the same estimate.
+func (s *Service) EstimatePasswordStrength(ctx context.Context, req *accountsV1.EstimatePasswordStrengthRequest) (*accountsV1.EstimatePasswordStrengthResponse, error) {
+	api := "EstimatePasswordStrength: "
+
+	ip := common.GetMetadataValue(ctx, XForwardedFor)
+	email := strings.ToLower(strings.TrimSpace(req.GetEmail()))
+	firstname := strings.TrimSpace(req.GetFirstName())
+	lastname := strings.TrimSpace(req.GetLastName())
+	pw := s.passwordPolicy.Normalize(strings.TrimSpace(req.GetPassword()))
+
+	errs := validator.Val(
+		s.validate,
+		validator.Field{
+			Param:          "password",
+			Message:        "Password invalid",
+			Value:          pw,
+			Tag:            "max=256",
+			OmitParamValue: true,
+		},
+		validator.Field{
+			Param:   XForwardedFor,
+			Message: XForwardedFor + " header required",
+			Value:   ip,
+			Tag:     `required`,
+		},
+	)
+	// Validate
+	if len(errs) > 0 {
+		return nil, s.returnErrors(ctx, errs, codes.InvalidArgument, "Malformed request", api)
+	}
+
+	est := password.Estimate(pw, email, firstname, lastname)
+	return &accountsV1.EstimatePasswordStrengthResponse{
+		Score:            int32(est.Score),
+		GuessesLog10:     math.Log10(est.Guesses),
+		CrackTimeSeconds: est.CrackTimeSeconds,
+		CrackTimeDisplay: est.CrackTimeDisplay,
+		Warning:          est.Warning,
+		Suggestions:      est.Suggestions,
+		MinScore:         int32(s.passwordPolicy.MinScore),
+	}, nil
+}
@@ -0,0 +1,50 @@
+package accounts
+
+import (
+	"testing"
+
+	pb "github.com/isaiahwong/accounts-go/api/accounts/v1"
+	"github.com/isaiahwong/accounts-go/internal/common/password"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestEstimatePasswordStrength(t *testing.T) {
+	policy := password.DefaultPolicy()
+	policy.MinScore = 3
+	svc := &Service{
+		logger:         logger,
+		passwordPolicy: policy,
+	}
+	svc.initValidator()
+	ctx := incomingContext(XForwardedFor, "127.0.0.1")
+
+	resp, err := svc.EstimatePasswordStrength(ctx, &pb.EstimatePasswordStrengthRequest{
+		Password:  "isaiahwong",
+		Email:     "isaiah@example.com",
+		FirstName: "Isaiah",
+		LastName:  "Wong",
+	})
+	assert.NoError(t, err)
+	assert.True(t, resp.Score < 3)
+	assert.Equal(t, "Avoid using your email or name", resp.Warning)
+	assert.NotEmpty(t, resp.Suggestions)
+	assert.Equal(t, int32(3), resp.MinScore)
+
+	// SignUp rejects what the estimate scores below the minimum
+	errs := svc.checkPassword("isaiahwong", "isaiah@example.com", "Isaiah", "Wong")
+	assert.Equal(t, password.RuleMinScore, errs[len(errs)-1].Value)
+
+	resp, err = svc.EstimatePasswordStrength(ctx, &pb.EstimatePasswordStrengthRequest{
+		Password: "correct horse battery staple",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(4), resp.Score)
+	assert.Empty(t, resp.Warning)
+
+	t.Run("Missing headers", func(t *testing.T) {
+		_, err := svc.EstimatePasswordStrength(incomingContext(), &pb.EstimatePasswordStrengthRequest{})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
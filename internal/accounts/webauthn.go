@@ -0,0 +1,403 @@
+package accounts
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	accountsV1 "github.com/isaiahwong/accounts-go/api/accounts/v1"
+	"github.com/isaiahwong/accounts-go/internal/common"
+	"github.com/isaiahwong/accounts-go/internal/common/validator"
+	"github.com/isaiahwong/accounts-go/internal/models"
+	"github.com/isaiahwong/accounts-go/internal/oauth"
+	"github.com/isaiahwong/accounts-go/internal/webauthn"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// BeginWebAuthnRegistration is a gRPC handler that issues the options for
+// registering a new passkey to the authenticated account
+func (s *Service) BeginWebAuthnRegistration(ctx context.Context, _ *accountsV1.Empty) (*accountsV1.WebAuthnOptions, error) {
+	api := "BeginWebAuthnRegistration: "
+
+	u, err := s.bearerRequest(ctx, &api)
+	if err != nil {
+		return nil, err
+	}
+
+	challenge, err := webauthn.NewChallenge()
+	if err != nil {
+		s.logger.Errorf("%v: %v", api, err)
+		return nil, status.Error(codes.Internal, "An Internal error has occurred")
+	}
+	_, err = s.accountsRepo.Update(
+		nil,
+		bson.M{"_id": u.ID},
+		bson.M{
+			"$set": bson.M{
+				"updated_at":         time.Now(),
+				"webauthn.challenge": webauthn.Encode(challenge),
+				"webauthn.expires":   time.Now().Add(s.webAuthn.Timeout()),
+			},
+		},
+	)
+	if err != nil {
+		s.logger.Errorf("%v: %v", api, err)
+		return nil, status.Error(codes.Internal, "An Internal error has occurred")
+	}
+
+	options, err := json.Marshal(s.webAuthn.CreationOptions(
+		webauthn.User{
+			ID:          u.ID[:],
+			Name:        u.Auth.Email,
+			DisplayName: u.Auth.Name,
+		},
+		challenge,
+		credentialIDs(u),
+	))
+	if err != nil {
+		s.logger.Errorf("%v: %v", api, err)
+		return nil, status.Error(codes.Internal, "An Internal error has occurred")
+	}
+	return &accountsV1.WebAuthnOptions{Options: options}, nil
+}
+
+// FinishWebAuthnRegistration is a gRPC handler that verifies the
+// authenticator's response to BeginWebAuthnRegistration and stores the new
+// credential on the account
+func (s *Service) FinishWebAuthnRegistration(ctx context.Context, req *accountsV1.WebAuthnCredentialRequest) (*accountsV1.Empty, error) {
+	api := "FinishWebAuthnRegistration: "
+
+	errs := validator.Val(
+		s.validate,
+		validator.Field{
+			Param:          "credential",
+			Message:        "Invalid credential",
+			Value:          req.GetCredential(),
+			Tag:            `required`,
+			OmitParamValue: true,
+		},
+	)
+	u, err := s.bearerRequest(ctx, &api, errs...)
+	if err != nil {
+		return nil, err
+	}
+	if u.WebAuthn.Challenge == "" || time.Now().After(u.WebAuthn.Expires) {
+		return nil, status.Error(codes.FailedPrecondition, "No pending passkey registration")
+	}
+	challenge, err := webauthn.Decode(u.WebAuthn.Challenge)
+	if err != nil {
+		s.logger.Errorf("%v: %v", api, err)
+		return nil, status.Error(codes.Internal, "An Internal error has occurred")
+	}
+
+	cred, err := s.webAuthn.VerifyRegistration(req.GetCredential(), challenge)
+	if err != nil {
+		s.logger.Warnf("%v: %v", api, err)
+		return nil, s.returnErrors(ctx, []validator.Error{
+			{
+				Param:   "credential",
+				Message: "Invalid credential",
+			},
+		}, codes.InvalidArgument, "Invalid credential", api)
+	}
+
+	// Credential IDs are unique across accounts
+	existing, err := s.accountsRepo.FindOne(nil, bson.M{"webauthn.credentials.id": cred.ID})
+	if err != nil {
+		s.logger.Errorf("%v: %v", api, err)
+		return nil, status.Error(codes.Internal, "An Internal error has occurred")
+	}
+	if existing != nil {
+		return nil, status.Error(codes.AlreadyExists, "Passkey is already registered")
+	}
+
+	c := models.WebAuthnCredential{
+		ID:        cred.ID,
+		PublicKey: cred.PublicKey,
+		SignCount: int64(cred.SignCount),
+		AAGUID:    cred.AAGUID,
+		CreatedAt: time.Now(),
+	}
+	set := bson.M{
+		"updated_at":         time.Now(),
+		"webauthn.challenge": "",
+	}
+	update := bson.M{"$set": set}
+	if len(u.WebAuthn.Credentials) == 0 {
+		// Credentials may be stored as null which $push rejects
+		set["webauthn.credentials"] = []models.WebAuthnCredential{c}
+	} else {
+		update["$push"] = bson.M{"webauthn.credentials": c}
+	}
+
+	// The challenge can only be used once
+	_, err = s.accountsRepo.Update(
+		nil,
+		bson.M{"_id": u.ID, "webauthn.challenge": u.WebAuthn.Challenge},
+		update,
+	)
+	if err == mongo.ErrNoDocuments {
+		return nil, status.Error(codes.FailedPrecondition, "No pending passkey registration")
+	}
+	if err != nil {
+		s.logger.Errorf("%v: %v", api, err)
+		return nil, status.Error(codes.Internal, "An Internal error has occurred")
+	}
+
+	return &accountsV1.Empty{}, nil
+}
+
+// BeginWebAuthnLogin is a gRPC handler that issues the options for signing
+// in with a passkey against a login challenge. Accounts which are unknown or
+// have no passkeys are given decoy credential IDs so the options do not
+// reveal either.
+func (s *Service) BeginWebAuthnLogin(ctx context.Context, req *accountsV1.BeginWebAuthnLoginRequest) (*accountsV1.WebAuthnOptions, error) {
+	api := "BeginWebAuthnLogin: "
+
+	ip := common.GetMetadataValue(ctx, XForwardedFor)
+	challenge := common.GetMetadataValue(ctx, LoginChallenge)
+	email := strings.ToLower(strings.TrimSpace(req.GetEmail()))
+
+	errs := validator.Val(
+		s.validate,
+		validator.Field{
+			Param:   "email",
+			Message: "Invalid email",
+			Value:   email,
+			Tag:     "required,email,max=64",
+		},
+		validator.Field{
+			Param:   XForwardedFor,
+			Message: XForwardedFor + " header required",
+			Value:   ip,
+			Tag:     `required`,
+		},
+		validator.Field{
+			Param:   LoginChallenge,
+			Message: LoginChallenge + " header required",
+			Value:   challenge,
+			Tag:     `required`,
+		},
+	)
+	// Validate
+	if len(errs) > 0 {
+		return nil, s.returnErrors(ctx, errs, codes.InvalidArgument, "Malformed request", api)
+	}
+	// Prepend IP for logging
+	api = fmt.Sprintf("[%v] %v", ip, api)
+
+	u, err := s.findAccountByEmail(nil, email)
+	if err != nil {
+		s.logger.Errorf("%v: %v", api, err)
+		return nil, status.Error(codes.Internal, "An Internal error has occurred")
+	}
+
+	c, err := webauthn.NewChallenge()
+	if err != nil {
+		s.logger.Errorf("%v: %v", api, err)
+		return nil, status.Error(codes.Internal, "An Internal error has occurred")
+	}
+
+	allow := s.decoyCredentialIDs(email)
+	if u != nil && len(u.WebAuthn.Credentials) > 0 {
+		// Each login challenge has its own assertion so a request for one
+		// cannot replace that of another
+		digest := hashToken(challenge)
+		update := bson.M{
+			"$set": bson.M{
+				"updated_at": time.Now(),
+				"webauthn.assertions." + digest: models.WebAuthnAssertion{
+					Challenge: webauthn.Encode(c),
+					Expires:   time.Now().Add(s.webAuthn.Timeout()),
+				},
+			},
+		}
+		// Drop expired assertions so they do not accumulate
+		expired := bson.M{}
+		for k, a := range u.WebAuthn.Assertions {
+			if k != digest && a.Expires.Before(time.Now()) {
+				expired["webauthn.assertions."+k] = ""
+			}
+		}
+		if len(expired) > 0 {
+			update["$unset"] = expired
+		}
+		_, err = s.accountsRepo.Update(nil, bson.M{"_id": u.ID}, update)
+		if err != nil {
+			s.logger.Errorf("%v: %v", api, err)
+			return nil, status.Error(codes.Internal, "An Internal error has occurred")
+		}
+		allow = credentialIDs(u)
+	}
+
+	options, err := json.Marshal(s.webAuthn.RequestOptions(c, allow))
+	if err != nil {
+		s.logger.Errorf("%v: %v", api, err)
+		return nil, status.Error(codes.Internal, "An Internal error has occurred")
+	}
+	return &accountsV1.WebAuthnOptions{Options: options}, nil
+}
+
+// FinishWebAuthnLogin is a gRPC handler that verifies the authenticator's
+// assertion and accepts the login challenge. Assertions without user
+// verification defer to the second factor when one is enabled.
+func (s *Service) FinishWebAuthnLogin(ctx context.Context, req *accountsV1.WebAuthnCredentialRequest) (*accountsV1.RedirectResponse, error) {
+	api := "FinishWebAuthnLogin: "
+
+	ip := common.GetMetadataValue(ctx, XForwardedFor)
+	challenge := common.GetMetadataValue(ctx, LoginChallenge)
+
+	errs := validator.Val(
+		s.validate,
+		validator.Field{
+			Param:          "credential",
+			Message:        "Invalid credential",
+			Value:          req.GetCredential(),
+			Tag:            `required`,
+			OmitParamValue: true,
+		},
+		validator.Field{
+			Param:   XForwardedFor,
+			Message: XForwardedFor + " header required",
+			Value:   ip,
+			Tag:     `required`,
+		},
+		validator.Field{
+			Param:   LoginChallenge,
+			Message: LoginChallenge + " header required",
+			Value:   challenge,
+			Tag:     `required`,
+		},
+	)
+	// Validate
+	if len(errs) > 0 {
+		return nil, s.returnErrors(ctx, errs, codes.InvalidArgument, "Malformed request", api)
+	}
+	// Prepend IP for logging
+	api = fmt.Sprintf("[%v] %v", ip, api)
+
+	digest := hashToken(challenge)
+	key := "webauthn.assertions." + digest
+	u, err := s.accountsRepo.FindOne(nil, bson.M{
+		key + ".expires": bson.M{"$gt": time.Now()},
+	})
+	if err != nil {
+		s.logger.Errorf("%v: %v", api, err)
+		return nil, status.Error(codes.Internal, "An Internal error has occurred")
+	}
+	if u == nil {
+		return nil, status.Error(codes.PermissionDenied, "Login session has expired. Please sign in again")
+	}
+	pending, ok := u.WebAuthn.Assertions[digest]
+	if !ok {
+		return nil, status.Error(codes.PermissionDenied, "Login session has expired. Please sign in again")
+	}
+
+	invalid := func() error {
+		return s.returnErrors(ctx, []validator.Error{
+			{
+				Param:   "credential",
+				Message: "Invalid credential",
+			},
+		}, codes.PermissionDenied, "Invalid credential", api)
+	}
+
+	assertion, err := webauthn.ParseAssertion(req.GetCredential())
+	if err != nil {
+		s.logger.Warnf("%v: %v", api, err)
+		return nil, invalid()
+	}
+	var stored *models.WebAuthnCredential
+	for i := range u.WebAuthn.Credentials {
+		if bytes.Equal(u.WebAuthn.Credentials[i].ID, assertion.CredentialID) {
+			stored = &u.WebAuthn.Credentials[i]
+			break
+		}
+	}
+	if stored == nil {
+		return nil, invalid()
+	}
+	c, err := webauthn.Decode(pending.Challenge)
+	if err != nil {
+		s.logger.Errorf("%v: %v", api, err)
+		return nil, status.Error(codes.Internal, "An Internal error has occurred")
+	}
+
+	count, verified, err := s.webAuthn.VerifyAssertion(assertion, c, webauthn.Credential{
+		ID:        stored.ID,
+		PublicKey: stored.PublicKey,
+		SignCount: uint32(stored.SignCount),
+	})
+	if err != nil {
+		s.logger.Warnf("%v: %v", api, err)
+		return nil, invalid()
+	}
+
+	// The challenge can only be used once
+	_, err = s.accountsRepo.Update(
+		nil,
+		bson.M{
+			"_id":                     u.ID,
+			key + ".challenge":        pending.Challenge,
+			"webauthn.credentials.id": stored.ID,
+		},
+		bson.M{
+			"$set": bson.M{
+				"updated_at":                        time.Now(),
+				"webauthn.credentials.$.sign_count": int64(count),
+				"webauthn.credentials.$.last_used":  time.Now(),
+			},
+			"$unset": bson.M{key: ""},
+		},
+	)
+	if err == mongo.ErrNoDocuments {
+		return nil, status.Error(codes.PermissionDenied, "Login session has expired. Please sign in again")
+	}
+	if err != nil {
+		s.logger.Errorf("%v: %v", api, err)
+		return nil, status.Error(codes.Internal, "An Internal error has occurred")
+	}
+
+	// Without user verification on the authenticator the passkey is a single
+	// factor, so the enrolled second factor is still required
+	if !verified {
+		return s.completeLogin(ctx, challenge, u, api, oauth.AMRHardwareKey)
+	}
+	r, err := s.acceptLogin(challenge, u, oauth.ACRMultiFactor, oauth.AMRHardwareKey, oauth.AMRMFA)
+	if err != nil {
+		s.logger.Errorf("%v: acceptLogin: %v", api, err)
+		// Cast  to hydra error
+		if he, ok := err.(*oauth.HydraError); ok {
+			return nil, s.returnHydraError(ctx, he, api)
+		}
+		return nil, status.Error(codes.Internal, "An Internal error has occurred")
+	}
+
+	return &accountsV1.RedirectResponse{RedirectTo: r.RedirectTo}, nil
+}
+
+// credentialIDs returns the IDs of the account's registered credentials
+func credentialIDs(u *models.Account) [][]byte {
+	ids := make([][]byte, 0, len(u.WebAuthn.Credentials))
+	for _, c := range u.WebAuthn.Credentials {
+		ids = append(ids, c.ID)
+	}
+	return ids
+}
+
+// decoyCredentialIDs returns a credential ID derived from the email, offered
+// in place of real ones so unknown accounts look like accounts with a
+// passkey. The same email always gets the same ID.
+func (s *Service) decoyCredentialIDs(email string) [][]byte {
+	mac := hmac.New(sha256.New, s.webAuthnDecoyKey)
+	mac.Write([]byte(email))
+	return [][]byte{mac.Sum(nil)}
+}
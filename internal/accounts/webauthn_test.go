@@ -0,0 +1,244 @@
+package accounts
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	pb "github.com/isaiahwong/accounts-go/api/accounts/v1"
+	"github.com/isaiahwong/accounts-go/internal/models"
+	"github.com/isaiahwong/accounts-go/internal/oauth"
+	"github.com/isaiahwong/accounts-go/internal/webauthn"
+	"github.com/isaiahwong/accounts-go/internal/webauthn/webauthntest"
+	"github.com/isaiahwong/accounts-go/tests/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestWebAuthn(t *testing.T) {
+	acc := &models.Account{
+		ID: primitive.NewObjectID(),
+		Auth: models.Auth{
+			Email: "isaiah@example.com",
+			Name:  "Isaiah Wong",
+		},
+	}
+
+	var accepted *oauth.HydraLoginAccept
+	hydra, srv := setupHydra(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPut {
+			accepted = &oauth.HydraLoginAccept{}
+			json.NewDecoder(r.Body).Decode(accepted)
+			json.NewEncoder(w).Encode(oauth.HydraRedirect{RedirectTo: "https://example.com/callback"})
+			return
+		}
+		json.NewEncoder(w).Encode(oauth.InstrospectResponse{Active: true, Sub: acc.ID.Hex()})
+	})
+	defer srv.Close()
+
+	// Apply $set, $unset and $push on the webauthn sub document to acc
+	repo := new(mocks.Repo)
+	repo.On("GetTimeout").Return(time.Second)
+	repo.On("FindOne", mock.Anything, mock.MatchedBy(func(f bson.M) bool {
+		_, ok := f["webauthn.credentials.id"]
+		return ok
+	})).Return(nil, nil)
+	repo.On("FindOne", mock.Anything, mock.Anything).Return(acc, nil)
+	repo.On("Update", nil, mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		up := args.Get(2).(bson.M)
+		if set, ok := up["$set"].(bson.M); ok {
+			if v, ok := set["webauthn.challenge"].(string); ok {
+				acc.WebAuthn.Challenge = v
+			}
+			if v, ok := set["webauthn.expires"].(time.Time); ok {
+				acc.WebAuthn.Expires = v
+			}
+			for k, v := range set {
+				if a, ok := v.(models.WebAuthnAssertion); ok {
+					if acc.WebAuthn.Assertions == nil {
+						acc.WebAuthn.Assertions = map[string]models.WebAuthnAssertion{}
+					}
+					acc.WebAuthn.Assertions[strings.TrimPrefix(k, "webauthn.assertions.")] = a
+				}
+			}
+			if v, ok := set["webauthn.credentials"].([]models.WebAuthnCredential); ok {
+				acc.WebAuthn.Credentials = v
+			}
+			if v, ok := set["webauthn.credentials.$.sign_count"].(int64); ok {
+				acc.WebAuthn.Credentials[0].SignCount = v
+			}
+		}
+		if unset, ok := up["$unset"].(bson.M); ok {
+			for k := range unset {
+				delete(acc.WebAuthn.Assertions, strings.TrimPrefix(k, "webauthn.assertions."))
+			}
+		}
+		if push, ok := up["$push"].(bson.M); ok {
+			acc.WebAuthn.Credentials = append(acc.WebAuthn.Credentials, push["webauthn.credentials"].(models.WebAuthnCredential))
+		}
+	}).Return(1, nil)
+
+	svc := &Service{
+		logger:           logger,
+		accountsRepo:     repo,
+		oAuthClient:      hydra,
+		webAuthnDecoyKey: []byte("decoy"),
+		webAuthn: webauthn.New(webauthn.Config{
+			RPID:    "example.com",
+			RPName:  "Example",
+			Origins: []string{"https://example.com"},
+		}),
+	}
+	svc.initValidator()
+	authenticator := webauthntest.New("example.com", "https://example.com")
+
+	t.Run("Register", func(t *testing.T) {
+		ctx := incomingContext(
+			XForwardedFor, "127.0.0.1",
+			Authorization, "Bearer token",
+		)
+		resp, err := svc.BeginWebAuthnRegistration(ctx, &pb.Empty{})
+		if !assert.NoError(t, err) {
+			t.FailNow()
+		}
+		options := &webauthn.CreationOptions{}
+		assert.NoError(t, json.Unmarshal(resp.GetOptions(), options))
+		assert.Equal(t, "example.com", options.RP.ID)
+
+		cred, err := authenticator.Create(options)
+		assert.NoError(t, err)
+		_, err = svc.FinishWebAuthnRegistration(ctx, &pb.WebAuthnCredentialRequest{Credential: cred})
+		assert.NoError(t, err)
+		assert.Len(t, acc.WebAuthn.Credentials, 1)
+		assert.Empty(t, acc.WebAuthn.Challenge)
+
+		// Challenge is single use
+		_, err = svc.FinishWebAuthnRegistration(ctx, &pb.WebAuthnCredentialRequest{Credential: cred})
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	})
+
+	ctx := incomingContext(
+		XForwardedFor, "127.0.0.1",
+		LoginChallenge, "challenge",
+	)
+	login := func(t *testing.T) *webauthn.RequestOptions {
+		resp, err := svc.BeginWebAuthnLogin(ctx, &pb.BeginWebAuthnLoginRequest{Email: acc.Auth.Email})
+		if !assert.NoError(t, err) {
+			t.FailNow()
+		}
+		options := &webauthn.RequestOptions{}
+		assert.NoError(t, json.Unmarshal(resp.GetOptions(), options))
+		assert.NotEmpty(t, options.AllowCredentials)
+		return options
+	}
+
+	t.Run("Wrong origin", func(t *testing.T) {
+		// Same credential used from a look-alike origin
+		phished := *authenticator
+		phished.Origin = "https://examp1e.com"
+		cred, err := phished.Get(login(t), acc.ID[:])
+		assert.NoError(t, err)
+		_, err = svc.FinishWebAuthnLogin(ctx, &pb.WebAuthnCredentialRequest{Credential: cred})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+		assert.Nil(t, accepted, "login should not be accepted")
+	})
+
+	t.Run("Unknown accounts", func(t *testing.T) {
+		options := func(email string) *webauthn.RequestOptions {
+			resp, err := svc.BeginWebAuthnLogin(ctx, &pb.BeginWebAuthnLoginRequest{Email: email})
+			assert.NoError(t, err)
+			options := &webauthn.RequestOptions{}
+			assert.NoError(t, json.Unmarshal(resp.GetOptions(), options))
+			return options
+		}
+		known := options(acc.Auth.Email)
+
+		// Served an account without passkeys for any other email
+		creds := acc.WebAuthn.Credentials
+		acc.WebAuthn.Credentials = nil
+		defer func() { acc.WebAuthn.Credentials = creds }()
+		unknown := options("unknown@example.com")
+		assert.Len(t, unknown.AllowCredentials, len(known.AllowCredentials))
+		assert.Equal(t, known.AllowCredentials[0].Type, unknown.AllowCredentials[0].Type)
+		assert.NotEqual(t, known.AllowCredentials[0].ID, unknown.AllowCredentials[0].ID)
+		assert.Equal(t, unknown.AllowCredentials, options("unknown@example.com").AllowCredentials)
+		assert.NotEqual(t, unknown.AllowCredentials, options("other@example.com").AllowCredentials)
+	})
+
+	t.Run("Login does not cancel registration", func(t *testing.T) {
+		regCtx := incomingContext(
+			XForwardedFor, "127.0.0.1",
+			Authorization, "Bearer token",
+		)
+		resp, err := svc.BeginWebAuthnRegistration(regCtx, &pb.Empty{})
+		assert.NoError(t, err)
+		options := &webauthn.CreationOptions{}
+		assert.NoError(t, json.Unmarshal(resp.GetOptions(), options))
+
+		login(t)
+		cred, err := authenticator.Create(options)
+		assert.NoError(t, err)
+		_, err = svc.FinishWebAuthnRegistration(regCtx, &pb.WebAuthnCredentialRequest{Credential: cred})
+		assert.NoError(t, err)
+		assert.Len(t, acc.WebAuthn.Credentials, 2)
+	})
+
+	t.Run("Login", func(t *testing.T) {
+		cred, err := authenticator.Get(login(t), acc.ID[:])
+		assert.NoError(t, err)
+		resp, err := svc.FinishWebAuthnLogin(ctx, &pb.WebAuthnCredentialRequest{Credential: cred})
+		assert.NoError(t, err)
+		assert.Equal(t, "https://example.com/callback", resp.GetRedirectTo())
+		assert.Equal(t, acc.ID.Hex(), accepted.Subject)
+		assert.Equal(t, oauth.ACRMultiFactor, accepted.Acr)
+		assert.Contains(t, accepted.Amr, oauth.AMRHardwareKey)
+		assert.NotZero(t, acc.WebAuthn.Credentials[0].SignCount)
+	})
+
+	t.Run("Login for another challenge", func(t *testing.T) {
+		options := login(t)
+		// Begun by anyone who knows the email
+		other := incomingContext(
+			XForwardedFor, "127.0.0.2",
+			LoginChallenge, "other",
+		)
+		_, err := svc.BeginWebAuthnLogin(other, &pb.BeginWebAuthnLoginRequest{Email: acc.Auth.Email})
+		assert.NoError(t, err)
+		assert.Len(t, acc.WebAuthn.Assertions, 2)
+
+		cred, err := authenticator.Get(options, acc.ID[:])
+		assert.NoError(t, err)
+		resp, err := svc.FinishWebAuthnLogin(ctx, &pb.WebAuthnCredentialRequest{Credential: cred})
+		assert.NoError(t, err)
+		assert.Equal(t, "https://example.com/callback", resp.GetRedirectTo())
+		assert.Len(t, acc.WebAuthn.Assertions, 1)
+
+		// Assertions are single use
+		_, err = svc.FinishWebAuthnLogin(ctx, &pb.WebAuthnCredentialRequest{Credential: cred})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+
+	t.Run("Second factor without user verification", func(t *testing.T) {
+		acc.MFA.Enabled = true
+		authenticator.UserVerified = false
+		accepted = nil
+		defer func() {
+			acc.MFA.Enabled = false
+			authenticator.UserVerified = true
+		}()
+
+		cred, err := authenticator.Get(login(t), acc.ID[:])
+		assert.NoError(t, err)
+		resp, err := svc.FinishWebAuthnLogin(ctx, &pb.WebAuthnCredentialRequest{Credential: cred})
+		assert.NoError(t, err)
+		assert.True(t, resp.GetMfaRequired())
+		assert.Equal(t, []string{"totp"}, resp.GetMfaMethods())
+		assert.Nil(t, accepted, "login should not be accepted")
+	})
+}
@@ -0,0 +1,296 @@
+// Package breach screens passwords against a local corpus of breached
+// password hashes such as the Pwned Passwords SHA-1 dump. Lookups do not
+// leave the host.
+//
+// The corpus is either the dump itself, a file of uppercase SHA-1 hex
+// digests optionally followed by ":<count>" and sorted by digest, or a
+// bloom filter built from it with Build.
+package breach
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha1"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"strings"
+)
+
+// ErrMalformedFilter is returned when reading a file which is not a filter
+var ErrMalformedFilter = errors.New("breach: malformed bloom filter")
+
+// Checker reports whether a password appears in a breach corpus
+type Checker interface {
+	Contains(password string) (bool, error)
+}
+
+// Open returns a Checker for the corpus at path. format is "sorted" for a
+// sorted hash file or "bloom" for a filter written by Bloom.WriteTo.
+func Open(path, format string) (Checker, error) {
+	switch format {
+	case "sorted":
+		return OpenSorted(path)
+	case "bloom":
+		f, err := os.Open(path)
+		if err != nil {
+			return nil, err
+		}
+		defer f.Close()
+		return ReadBloom(bufio.NewReader(f))
+	default:
+		return nil, fmt.Errorf("breach: unsupported format %q", format)
+	}
+}
+
+// digest returns the SHA-1 digest of password in uppercase hex
+func digest(password string) string {
+	sum := sha1.Sum([]byte(password))
+	return strings.ToUpper(hex.EncodeToString(sum[:]))
+}
+
+// parseLine returns the digest of a corpus line of the form
+// "<hex digest>[:<count>]"
+func parseLine(line []byte) ([sha1.Size]byte, error) {
+	var sum [sha1.Size]byte
+	if i := bytes.IndexByte(line, ':'); i >= 0 {
+		line = line[:i]
+	}
+	line = bytes.TrimSpace(line)
+	if len(line) != hex.EncodedLen(sha1.Size) {
+		return sum, fmt.Errorf("breach: malformed line %q", line)
+	}
+	if _, err := hex.Decode(sum[:], line); err != nil {
+		return sum, fmt.Errorf("breach: malformed line %q", line)
+	}
+	return sum, nil
+}
+
+// Sorted looks up digests in a sorted corpus file by binary search
+type Sorted struct {
+	r    io.ReaderAt
+	size int64
+}
+
+// OpenSorted opens a sorted corpus file. The file is kept open for the
+// life of the process.
+func OpenSorted(path string) (*Sorted, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	fi, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+	return NewSorted(f, fi.Size()), nil
+}
+
+// NewSorted returns a Sorted reading size bytes of a corpus from r
+func NewSorted(r io.ReaderAt, size int64) *Sorted {
+	return &Sorted{r: r, size: size}
+}
+
+// Contains implements Checker
+func (s *Sorted) Contains(password string) (bool, error) {
+	target := []byte(digest(password))
+	lo, hi := int64(0), s.size
+	for lo < hi {
+		mid := lo + (hi-lo)/2
+		start, err := s.lineStart(mid)
+		if err != nil {
+			return false, err
+		}
+		if start >= hi {
+			hi = mid
+			continue
+		}
+		line, err := s.readLine(start)
+		if err != nil {
+			return false, err
+		}
+		key := line
+		if i := bytes.IndexByte(key, ':'); i >= 0 {
+			key = key[:i]
+		}
+		switch c := bytes.Compare(bytes.ToUpper(bytes.TrimSpace(key)), target); {
+		case c == 0:
+			return true, nil
+		case c < 0:
+			lo = start + int64(len(line)) + 1
+		default:
+			hi = mid
+		}
+	}
+	return false, nil
+}
+
+// lineStart returns the offset of the first line starting at or after off
+func (s *Sorted) lineStart(off int64) (int64, error) {
+	if off == 0 {
+		return 0, nil
+	}
+	buf := make([]byte, 64)
+	for pos := off - 1; pos < s.size; pos += int64(len(buf)) {
+		n, err := s.r.ReadAt(buf, pos)
+		if i := bytes.IndexByte(buf[:n], '\n'); i >= 0 {
+			return pos + int64(i) + 1, nil
+		}
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return 0, err
+		}
+	}
+	return s.size, nil
+}
+
+// readLine returns the line starting at off without its newline
+func (s *Sorted) readLine(off int64) ([]byte, error) {
+	var line []byte
+	buf := make([]byte, 64)
+	for pos := off; pos < s.size; pos += int64(len(buf)) {
+		n, err := s.r.ReadAt(buf, pos)
+		if i := bytes.IndexByte(buf[:n], '\n'); i >= 0 {
+			return append(line, buf[:i]...), nil
+		}
+		line = append(line, buf[:n]...)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	return line, nil
+}
+
+// bloomMagic identifies filter files
+var bloomMagic = []byte("BRF1")
+
+// Bloom is a bloom filter of password digests. Indexes are derived from the
+// digest by double hashing.
+type Bloom struct {
+	bits []uint64
+	m    uint64
+	k    uint32
+}
+
+// NewBloom returns an empty filter sized for n digests at false positive
+// rate p
+func NewBloom(n uint64, p float64) *Bloom {
+	if n == 0 {
+		n = 1
+	}
+	m := uint64(math.Ceil(-float64(n) * math.Log(p) / (math.Ln2 * math.Ln2)))
+	k := uint32(math.Max(1, math.Round(float64(m)/float64(n)*math.Ln2)))
+	return &Bloom{bits: make([]uint64, (m+63)/64), m: m, k: k}
+}
+
+func (b *Bloom) indexes(sum [sha1.Size]byte, f func(i uint64) bool) bool {
+	h1 := binary.BigEndian.Uint64(sum[0:8])
+	h2 := binary.BigEndian.Uint64(sum[8:16]) | 1
+	for i := uint32(0); i < b.k; i++ {
+		if !f((h1 + uint64(i)*h2) % b.m) {
+			return false
+		}
+	}
+	return true
+}
+
+// Add adds a digest to the filter
+func (b *Bloom) Add(sum [sha1.Size]byte) {
+	b.indexes(sum, func(i uint64) bool {
+		b.bits[i/64] |= 1 << (i % 64)
+		return true
+	})
+}
+
+// Contains implements Checker. False positives occur at the rate the
+// filter was built for.
+func (b *Bloom) Contains(password string) (bool, error) {
+	return b.indexes(sha1.Sum([]byte(password)), func(i uint64) bool {
+		return b.bits[i/64]&(1<<(i%64)) != 0
+	}), nil
+}
+
+// WriteTo writes the filter in the format read by ReadBloom
+func (b *Bloom) WriteTo(w io.Writer) (int64, error) {
+	bw := bufio.NewWriter(w)
+	bw.Write(bloomMagic)
+	binary.Write(bw, binary.BigEndian, b.m)
+	binary.Write(bw, binary.BigEndian, b.k)
+	var word [8]byte
+	for _, x := range b.bits {
+		binary.BigEndian.PutUint64(word[:], x)
+		bw.Write(word[:])
+	}
+	if err := bw.Flush(); err != nil {
+		return 0, err
+	}
+	return int64(len(bloomMagic) + 12 + 8*len(b.bits)), nil
+}
+
+// ReadBloom reads a filter written by WriteTo
+func ReadBloom(r io.Reader) (*Bloom, error) {
+	magic := make([]byte, len(bloomMagic))
+	if _, err := io.ReadFull(r, magic); err != nil || !bytes.Equal(magic, bloomMagic) {
+		return nil, ErrMalformedFilter
+	}
+	b := &Bloom{}
+	if err := binary.Read(r, binary.BigEndian, &b.m); err != nil {
+		return nil, ErrMalformedFilter
+	}
+	if err := binary.Read(r, binary.BigEndian, &b.k); err != nil {
+		return nil, ErrMalformedFilter
+	}
+	if b.m == 0 || b.k == 0 {
+		return nil, ErrMalformedFilter
+	}
+	b.bits = make([]uint64, (b.m+63)/64)
+	buf := make([]byte, 8*1024)
+	for i := 0; i < len(b.bits); {
+		n := len(b.bits) - i
+		if n > len(buf)/8 {
+			n = len(buf) / 8
+		}
+		if _, err := io.ReadFull(r, buf[:8*n]); err != nil {
+			return nil, ErrMalformedFilter
+		}
+		for j := 0; j < n; j++ {
+			b.bits[i+j] = binary.BigEndian.Uint64(buf[8*j:])
+		}
+		i += n
+	}
+	return b, nil
+}
+
+// Build returns a filter of the digests in a corpus read from r sized for
+// n digests at false positive rate p. The number of digests added is
+// returned.
+func Build(r io.Reader, n uint64, p float64) (*Bloom, uint64, error) {
+	b := NewBloom(n, p)
+	var added uint64
+	sc := bufio.NewScanner(r)
+	for sc.Scan() {
+		if len(bytes.TrimSpace(sc.Bytes())) == 0 {
+			continue
+		}
+		sum, err := parseLine(sc.Bytes())
+		if err != nil {
+			return nil, added, err
+		}
+		b.Add(sum)
+		added++
+	}
+	if err := sc.Err(); err != nil {
+		return nil, added, err
+	}
+	return b, added, nil
+}
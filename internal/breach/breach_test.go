@@ -0,0 +1,88 @@
+package breach
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// corpus returns a sorted dump of the digests of passwords
+func corpus(passwords []string) string {
+	lines := make([]string, len(passwords))
+	for i, p := range passwords {
+		lines[i] = fmt.Sprintf("%v:%d\r\n", digest(p), i+1)
+	}
+	sort.Strings(lines)
+	return strings.Join(lines, "")
+}
+
+func breached(n int) []string {
+	passwords := make([]string, n)
+	for i := range passwords {
+		passwords[i] = fmt.Sprintf("password%d", i)
+	}
+	return passwords
+}
+
+func TestSorted(t *testing.T) {
+	passwords := breached(500)
+	dump := corpus(passwords)
+	s := NewSorted(strings.NewReader(dump), int64(len(dump)))
+
+	for _, p := range passwords {
+		ok, err := s.Contains(p)
+		assert.NoError(t, err)
+		assert.True(t, ok, p)
+	}
+	for _, p := range []string{"hello1234^", "password500", ""} {
+		ok, err := s.Contains(p)
+		assert.NoError(t, err)
+		assert.False(t, ok, p)
+	}
+
+	t.Run("Single line", func(t *testing.T) {
+		dump := digest("password")
+		s := NewSorted(strings.NewReader(dump), int64(len(dump)))
+		ok, _ := s.Contains("password")
+		assert.True(t, ok)
+		ok, _ = s.Contains("other")
+		assert.False(t, ok)
+	})
+}
+
+func TestBloom(t *testing.T) {
+	passwords := breached(1000)
+	b, added, err := Build(strings.NewReader(corpus(passwords)), 1000, 0.001)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(1000), added)
+
+	var buf bytes.Buffer
+	_, err = b.WriteTo(&buf)
+	assert.NoError(t, err)
+	b, err = ReadBloom(&buf)
+	assert.NoError(t, err)
+
+	for _, p := range passwords {
+		ok, err := b.Contains(p)
+		assert.NoError(t, err)
+		assert.True(t, ok, p)
+	}
+	var fp int
+	for i := 0; i < 10000; i++ {
+		if ok, _ := b.Contains(fmt.Sprintf("other%d", i)); ok {
+			fp++
+		}
+	}
+	assert.True(t, fp < 50, "false positives %d", fp)
+
+	t.Run("Malformed", func(t *testing.T) {
+		_, _, err := Build(strings.NewReader("password\n"), 1, 0.001)
+		assert.Error(t, err)
+		_, err = ReadBloom(strings.NewReader("BRF0"))
+		assert.Equal(t, ErrMalformedFilter, err)
+	})
+}
@@ -0,0 +1,337 @@
+package password
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"crypto/subtle"
+	"fmt"
+	"hash"
+	"strconv"
+	"strings"
+
+	"golang.org/x/crypto/pbkdf2"
+	"golang.org/x/crypto/scrypt"
+)
+
+// Legacy verifiers check hashes imported from other systems. Accounts are
+// rehashed with the default hasher on their next login.
+
+// PBKDF2 verifies PBKDF2 hashes. Methods are of the form
+// "pbkdf2-sha256$i=<iterations>,l=<key length>" and hashes "<salt>$<key>"
+// in base64.
+type PBKDF2 struct {
+	// Digest is one of sha1, sha256 or sha512
+	Digest string
+}
+
+// Name implements Verifier
+func (p PBKDF2) Name() string { return "pbkdf2-" + p.Digest }
+
+// Verify implements Verifier
+func (p PBKDF2) Verify(password, digest, params string) (bool, error) {
+	var h func() hash.Hash
+	switch p.Digest {
+	case "sha1":
+		h = sha1.New
+	case "sha256":
+		h = sha256.New
+	case "sha512":
+		h = sha512.New
+	default:
+		return false, ErrUnknownMethod
+	}
+	ps, err := parseParams(params, "i", "l")
+	if err != nil {
+		return false, err
+	}
+	salt, key, err := parseSaltedHash(digest)
+	if err != nil {
+		return false, err
+	}
+	k := pbkdf2.Key([]byte(password), salt, ps["i"], ps["l"], h)
+	return subtle.ConstantTimeCompare(k, key) == 1, nil
+}
+
+// pbkdf2MaxIterations bounds the iterations of imported PBKDF2 hashes
+const pbkdf2MaxIterations = 2000000
+
+// Check implements Checker
+func (p PBKDF2) Check(digest, params string) error {
+	switch p.Digest {
+	case "sha1", "sha256", "sha512":
+	default:
+		return ErrUnknownMethod
+	}
+	ps, err := parseParams(params, "i", "l")
+	if err != nil {
+		return err
+	}
+	if err := checkBounds(ps["i"], 1, pbkdf2MaxIterations); err != nil {
+		return err
+	}
+	if err := checkBounds(ps["l"], 16, 64); err != nil {
+		return err
+	}
+	return checkSaltedHash(digest, ps["l"])
+}
+
+// FirebaseScrypt verifies hashes exported from Firebase Authentication,
+// which encrypts the project's signer key with a scrypt derived key.
+// Methods are of the form "firebase-scrypt$r=<rounds>,m=<mem cost>" and
+// hashes "<salt>$<hash>" in base64 as exported.
+type FirebaseScrypt struct {
+	SignerKey     []byte
+	SaltSeparator []byte
+}
+
+// Name implements Verifier
+func (FirebaseScrypt) Name() string { return "firebase-scrypt" }
+
+// Verify implements Verifier
+func (f FirebaseScrypt) Verify(password, hash, params string) (bool, error) {
+	p, err := parseParams(params, "r", "m")
+	if err != nil {
+		return false, err
+	}
+	salt, key, err := parseSaltedHash(hash)
+	if err != nil {
+		return false, err
+	}
+	s := make([]byte, 0, len(salt)+len(f.SaltSeparator))
+	s = append(append(s, salt...), f.SaltSeparator...)
+	dk, err := scrypt.Key([]byte(password), s, 1<<uint(p["m"]), p["r"], 1, 32)
+	if err != nil {
+		return false, err
+	}
+	block, err := aes.NewCipher(dk)
+	if err != nil {
+		return false, err
+	}
+	k := make([]byte, len(f.SignerKey))
+	cipher.NewCTR(block, make([]byte, aes.BlockSize)).XORKeyStream(k, f.SignerKey)
+	return subtle.ConstantTimeCompare(k, key) == 1, nil
+}
+
+// Check implements Checker. The memory cost is a power of two exponent as
+// exported by Firebase, which uses 14.
+func (f FirebaseScrypt) Check(hash, params string) error {
+	p, err := parseParams(params, "r", "m")
+	if err != nil {
+		return err
+	}
+	if err := checkBounds(p["r"], 1, 16); err != nil {
+		return err
+	}
+	if err := checkBounds(p["m"], 1, 16); err != nil {
+		return err
+	}
+	return checkSaltedHash(hash, len(f.SignerKey))
+}
+
+// SHACrypt verifies SHA-256 and SHA-512 crypt hashes as produced by glibc,
+// e.g. "$6$rounds=5000$salt$digest". The method has no parameters.
+type SHACrypt struct{}
+
+// Name implements Verifier
+func (SHACrypt) Name() string { return "sha-crypt" }
+
+const (
+	shaCryptRounds    = 5000
+	shaCryptMinRounds = 1000
+	shaCryptMaxRounds = 999999999
+	shaCryptSaltLen   = 16
+)
+
+// Byte orders of the encoded digests
+var (
+	sha256CryptOrder = []int{
+		0, 10, 20, 21, 1, 11, 12, 22, 2, 3, 13, 23, 24, 4, 14,
+		15, 25, 5, 6, 16, 26, 27, 7, 17, 18, 28, 8, 9, 19, 29,
+	}
+	sha512CryptOrder = []int{
+		0, 21, 42, 22, 43, 1, 44, 2, 23, 3, 24, 45, 25, 46, 4,
+		47, 5, 26, 6, 27, 48, 28, 49, 7, 50, 8, 29, 9, 30, 51,
+		31, 52, 10, 53, 11, 32, 12, 33, 54, 34, 55, 13, 56, 14, 35,
+		15, 36, 57, 37, 58, 16, 59, 17, 38, 18, 39, 60, 40, 61, 19,
+		62, 20, 41,
+	}
+)
+
+// Verify implements Verifier
+func (SHACrypt) Verify(password, digest, params string) (bool, error) {
+	c, err := parseSHACrypt(digest)
+	if err != nil {
+		return false, err
+	}
+	k := shaCrypt(c.hash, c.id, []byte(password), []byte(c.salt), c.rounds, c.custom)
+	return subtle.ConstantTimeCompare([]byte(k), []byte(digest)) == 1, nil
+}
+
+// shaCryptImportMaxRounds bounds the rounds of imported SHA-crypt hashes
+const shaCryptImportMaxRounds = 1000000
+
+// Check implements Checker
+func (SHACrypt) Check(digest, params string) error {
+	c, err := parseSHACrypt(digest)
+	if err != nil {
+		return err
+	}
+	return checkBounds(c.rounds, 1, shaCryptImportMaxRounds)
+}
+
+// shaCryptHash is a parsed SHA-crypt hash
+type shaCryptHash struct {
+	hash   func() hash.Hash
+	id     string
+	salt   string
+	rounds int
+	custom bool
+}
+
+// parseSHACrypt parses a hash of the form "$<id>$[rounds=<n>$]<salt>$<digest>"
+func parseSHACrypt(digest string) (*shaCryptHash, error) {
+	p := strings.Split(digest, "$")
+	if len(p) < 4 || p[0] != "" {
+		return nil, ErrMalformedHash
+	}
+	c := &shaCryptHash{id: p[1], rounds: shaCryptRounds}
+	switch p[1] {
+	case "5":
+		c.hash = sha256.New
+	case "6":
+		c.hash = sha512.New
+	default:
+		return nil, ErrMalformedHash
+	}
+	if strings.HasPrefix(p[2], "rounds=") {
+		r, err := strconv.Atoi(strings.TrimPrefix(p[2], "rounds="))
+		if err != nil || len(p) != 5 {
+			return nil, ErrMalformedHash
+		}
+		c.rounds, c.custom = r, true
+		p = append(p[:2], p[3:]...)
+	}
+	if len(p) != 4 {
+		return nil, ErrMalformedHash
+	}
+	c.salt = p[2]
+	return c, nil
+}
+
+// shaCrypt implements the SHA-crypt algorithm by Ulrich Drepper
+func shaCrypt(newHash func() hash.Hash, id string, password, salt []byte, rounds int, custom bool) string {
+	if len(salt) > shaCryptSaltLen {
+		salt = salt[:shaCryptSaltLen]
+	}
+	if rounds < shaCryptMinRounds {
+		rounds = shaCryptMinRounds
+	}
+	if rounds > shaCryptMaxRounds {
+		rounds = shaCryptMaxRounds
+	}
+
+	// repeat returns b repeated to n bytes
+	repeat := func(b []byte, n int) []byte {
+		out := make([]byte, 0, n)
+		for len(out) < n {
+			out = append(out, b[:min(len(b), n-len(out))]...)
+		}
+		return out
+	}
+
+	h := newHash()
+	h.Write(password)
+	h.Write(salt)
+	h.Write(password)
+	b := h.Sum(nil)
+
+	h = newHash()
+	h.Write(password)
+	h.Write(salt)
+	h.Write(repeat(b, len(password)))
+	for i := len(password); i > 0; i >>= 1 {
+		if i&1 != 0 {
+			h.Write(b)
+		} else {
+			h.Write(password)
+		}
+	}
+	a := h.Sum(nil)
+
+	h = newHash()
+	for range password {
+		h.Write(password)
+	}
+	pb := repeat(h.Sum(nil), len(password))
+
+	h = newHash()
+	for i := 0; i < 16+int(a[0]); i++ {
+		h.Write(salt)
+	}
+	sb := repeat(h.Sum(nil), len(salt))
+
+	c := a
+	for i := 0; i < rounds; i++ {
+		h = newHash()
+		if i&1 != 0 {
+			h.Write(pb)
+		} else {
+			h.Write(c)
+		}
+		if i%3 != 0 {
+			h.Write(sb)
+		}
+		if i%7 != 0 {
+			h.Write(pb)
+		}
+		if i&1 != 0 {
+			h.Write(c)
+		} else {
+			h.Write(pb)
+		}
+		c = h.Sum(nil)
+	}
+
+	var out strings.Builder
+	out.WriteString("$" + id + "$")
+	if custom {
+		out.WriteString(fmt.Sprintf("rounds=%d$", rounds))
+	}
+	out.Write(salt)
+	out.WriteString("$")
+
+	order := sha256CryptOrder
+	if len(c) == sha512.Size {
+		order = sha512CryptOrder
+	}
+	for i := 0; i+2 < len(order); i += 3 {
+		cryptB64(&out, uint(c[order[i]])<<16|uint(c[order[i+1]])<<8|uint(c[order[i+2]]), 4)
+	}
+	// Remaining bytes
+	if len(c) == sha512.Size {
+		cryptB64(&out, uint(c[63]), 2)
+	} else {
+		cryptB64(&out, uint(c[31])<<8|uint(c[30]), 3)
+	}
+	return out.String()
+}
+
+const cryptAlphabet = "./0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz"
+
+// cryptB64 writes the n low 6 bit groups of w
+func cryptB64(out *strings.Builder, w uint, n int) {
+	for ; n > 0; n-- {
+		out.WriteByte(cryptAlphabet[w&0x3f])
+		w >>= 6
+	}
+}
+
+func min(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,78 @@
+package password
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/crypto/pbkdf2"
+)
+
+func TestSHACrypt(t *testing.T) {
+	// Vectors from the SHA-crypt specification, checked against glibc
+	vectors := []struct {
+		password, hash string
+	}{
+		{"Hello world!", "$5$saltstring$5B8vYYiY.CVt1RlTTf8KbXBH3hsxY/GNooZaBBGWEc5"},
+		{"Hello world!", "$5$rounds=10000$saltstringsaltst$3xv.VbSHBb41AL9AvLeujZkZRBAwqFMz2.opqey6IcA"},
+		{"Hello world!", "$6$saltstring$svn8UoSVapNtMuq1ukKS4tPQd8iKwSMHWjl/O817G3uBnIFNjnQJuesI68u4OTLiBFdcbYEdFCoEOfaS35inz1"},
+		{"Hello world!", "$6$rounds=10000$saltstringsaltst$OW1/O6BYHV6BcXZu8QVeXbDWra3Oeqh0sbHbbMCVNSnCM/UrjmM0Dp8vOuZeHBy/YTBmSK6H9qs/y3RnOaw5v."},
+	}
+	for _, v := range vectors {
+		ok, err := SHACrypt{}.Verify(v.password, v.hash, "")
+		assert.NoError(t, err)
+		assert.True(t, ok, v.hash)
+
+		ok, err = SHACrypt{}.Verify("Hello world", v.hash, "")
+		assert.NoError(t, err)
+		assert.False(t, ok)
+	}
+
+	_, err := SHACrypt{}.Verify("Hello world!", "$1$salt$digest", "")
+	assert.Equal(t, ErrMalformedHash, err)
+
+	assert.NoError(t, SHACrypt{}.Check(vectors[1].hash, ""))
+	assert.Equal(t, ErrMalformedHash, SHACrypt{}.Check("$1$salt$digest", ""))
+	assert.Equal(t, ErrUnsafeParams, SHACrypt{}.Check("$6$rounds=999999999$salt$digest", ""))
+}
+
+func TestPBKDF2(t *testing.T) {
+	salt := []byte("saltsaltsaltsalt")
+	key := pbkdf2.Key([]byte("hello1234^"), salt, 1000, 32, sha256.New)
+	hash := base64.StdEncoding.EncodeToString(salt) + "$" + base64.StdEncoding.EncodeToString(key)
+
+	r := NewRegistry(testArgon2id, PBKDF2{Digest: "sha256"})
+	ok, rehash, err := r.Verify("hello1234^", hash, "pbkdf2-sha256$i=1000,l=32")
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	assert.True(t, rehash)
+
+	ok, _, err = r.Verify("hello1234", hash, "pbkdf2-sha256$i=1000,l=32")
+	assert.NoError(t, err)
+	assert.False(t, ok)
+
+	assert.NoError(t, r.Check(hash, "pbkdf2-sha256$i=1000,l=32"))
+	assert.Equal(t, ErrMalformedHash, r.Check(hash, "pbkdf2-sha256$i=1000,l=64"))
+	assert.Equal(t, ErrUnsafeParams, r.Check(hash, "pbkdf2-sha256$i=1000000000,l=32"))
+}
+
+func TestFirebaseScrypt(t *testing.T) {
+	// Vector from Firebase's scrypt documentation
+	signerKey, _ := base64.StdEncoding.DecodeString("jxspr8Ki0RYycVU8zykbdLGjFQ3McFUH0uiiTvC8pVMXAn210wjLNmdZJzxUECKbm0QsEmYUSDzZvpjeJ9WmXA==")
+	separator, _ := base64.StdEncoding.DecodeString("Bw==")
+	f := FirebaseScrypt{SignerKey: signerKey, SaltSeparator: separator}
+	hash := "42xEC+ixf3L2lw==$lSrfV15cpx95/sZS2W9c9Kp6i/LVgQNDNC/qzrCnh1SAyZvqmZqAjTdn3aoItz+VHjoZilo78198JAdRuid5lQ=="
+
+	ok, err := f.Verify("user1password", hash, "r=8,m=14")
+	assert.NoError(t, err)
+	assert.True(t, ok)
+
+	ok, err = f.Verify("user2password", hash, "r=8,m=14")
+	assert.NoError(t, err)
+	assert.False(t, ok)
+
+	assert.NoError(t, f.Check(hash, "r=8,m=14"))
+	assert.Equal(t, ErrUnsafeParams, f.Check(hash, "r=8,m=40"))
+	assert.Equal(t, ErrUnsafeParams, f.Check(hash, "r=100,m=14"))
+}
@@ -0,0 +1,439 @@
+// Package password hashes and verifies passwords with a registry of
+// algorithms. Each hash is stored along with its method, the algorithm and
+// parameters it was created with, e.g. "argon2id$m=65536,t=3,p=4,l=32", so
+// hashes created with outdated parameters can be verified and upgraded.
+// Hashes imported from other systems are verified by legacy verifiers and
+// upgraded the same way.
+package password
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/bcrypt"
+	"golang.org/x/crypto/scrypt"
+)
+
+// ErrUnknownMethod is returned when verifying hashes of unregistered
+// algorithms
+var ErrUnknownMethod = errors.New("password: unknown hash method")
+
+// ErrMalformedHash is returned for hashes or methods which cannot be parsed
+var ErrMalformedHash = errors.New("password: malformed hash")
+
+// ErrUnsafeParams is returned by Check for hashes whose parameters would make
+// verifying them too costly
+var ErrUnsafeParams = errors.New("password: hash parameters out of bounds")
+
+const saltSize = 16
+
+var encoding = base64.RawStdEncoding
+
+// Verifier verifies password hashes of one algorithm
+type Verifier interface {
+	// Name identifies the algorithm
+	Name() string
+	// Verify reports whether password matches a hash created with params
+	Verify(password, hash, params string) (bool, error)
+}
+
+// Checker is implemented by verifiers which can validate a hash without a
+// password
+type Checker interface {
+	// Check returns ErrMalformedHash if hash or params cannot be parsed, and
+	// ErrUnsafeParams if they are outside the bounds the verifier accepts
+	Check(hash, params string) error
+}
+
+// Hasher creates password hashes with one algorithm
+type Hasher interface {
+	Verifier
+	// Method returns the name and parameters new hashes are created with
+	Method() string
+	// Hash returns the hash of password
+	Hash(password string) (string, error)
+}
+
+// Registry verifies hashes of every registered algorithm and creates new
+// ones with the default
+type Registry struct {
+	def     Hasher
+	hashers map[string]Verifier
+}
+
+// NewRegistry returns a Registry creating hashes with def. Legacy hashes
+// without a method are bcrypt so bcrypt is always registered.
+func NewRegistry(def Hasher, others ...Verifier) *Registry {
+	r := &Registry{def: def, hashers: map[string]Verifier{}}
+	r.Register(Bcrypt{Cost: bcrypt.DefaultCost})
+	for _, h := range others {
+		r.Register(h)
+	}
+	r.Register(def)
+	return r
+}
+
+// Register adds v, replacing any verifier of the same name
+func (r *Registry) Register(v Verifier) {
+	r.hashers[v.Name()] = v
+}
+
+// Supports reports whether hashes created with method can be verified
+func (r *Registry) Supports(method string) bool {
+	name, _ := split(method)
+	_, ok := r.hashers[name]
+	return ok
+}
+
+// Check validates a hash created with method before it is stored, e.g. one
+// imported from another system, so hashes which cannot be verified or would
+// take too long to are rejected upfront. An empty method is taken as bcrypt.
+func (r *Registry) Check(hash, method string) error {
+	if method == "" {
+		method = "bcrypt"
+	}
+	name, params := split(method)
+	h, found := r.hashers[name]
+	if !found {
+		return ErrUnknownMethod
+	}
+	if c, ok := h.(Checker); ok {
+		return c.Check(hash, params)
+	}
+	return nil
+}
+
+// Names returns the registered algorithms in order
+func (r *Registry) Names() []string {
+	names := make([]string, 0, len(r.hashers))
+	for n := range r.hashers {
+		names = append(names, n)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Hash returns the hash of password and its method using the default
+// algorithm
+func (r *Registry) Hash(password string) (hash string, method string, err error) {
+	hash, err = r.def.Hash(password)
+	if err != nil {
+		return "", "", err
+	}
+	return hash, r.def.Method(), nil
+}
+
+// Verify reports whether password matches hash created with method, and
+// whether the hash should be recreated with the default method. An empty
+// method is taken as bcrypt. An empty hash, as held by accounts without a
+// password, matches no password.
+func (r *Registry) Verify(password, hash, method string) (ok bool, rehash bool, err error) {
+	if hash == "" {
+		return false, false, nil
+	}
+	if method == "" {
+		method = bcryptMethod(hash)
+	}
+	name, params := split(method)
+	h, found := r.hashers[name]
+	if !found {
+		return false, false, ErrUnknownMethod
+	}
+	ok, err = h.Verify(password, hash, params)
+	if err != nil || !ok {
+		return false, false, err
+	}
+	return true, method != r.def.Method(), nil
+}
+
+// split separates a method into its name and parameters
+func split(method string) (string, string) {
+	i := strings.Index(method, "$")
+	if i < 0 {
+		return method, ""
+	}
+	return method[:i], method[i+1:]
+}
+
+// parseParams decodes parameters of the form "a=1,b=2" requiring each of
+// keys to be present
+func parseParams(params string, keys ...string) (map[string]int, error) {
+	m := map[string]int{}
+	for _, kv := range strings.Split(params, ",") {
+		p := strings.SplitN(kv, "=", 2)
+		if len(p) != 2 {
+			return nil, ErrMalformedHash
+		}
+		v, err := strconv.Atoi(p[1])
+		if err != nil || v <= 0 {
+			return nil, ErrMalformedHash
+		}
+		m[p[0]] = v
+	}
+	for _, k := range keys {
+		if _, ok := m[k]; !ok {
+			return nil, ErrMalformedHash
+		}
+	}
+	return m, nil
+}
+
+// checkBounds returns ErrUnsafeParams unless min <= v <= max
+func checkBounds(v, min, max int) error {
+	if v < min || v > max {
+		return ErrUnsafeParams
+	}
+	return nil
+}
+
+// checkSaltedHash checks a hash created by saltedHash holds a key of keyLen
+// bytes
+func checkSaltedHash(hash string, keyLen int) error {
+	salt, key, err := parseSaltedHash(hash)
+	if err != nil {
+		return err
+	}
+	if len(salt) == 0 || len(key) != keyLen {
+		return ErrMalformedHash
+	}
+	return nil
+}
+
+// saltedHash encodes a salt and derived key
+func saltedHash(salt, key []byte) string {
+	return encoding.EncodeToString(salt) + "$" + encoding.EncodeToString(key)
+}
+
+// parseSaltedHash decodes a hash created by saltedHash
+func parseSaltedHash(hash string) (salt, key []byte, err error) {
+	p := strings.Split(hash, "$")
+	if len(p) != 2 {
+		return nil, nil, ErrMalformedHash
+	}
+	if salt, err = decode(p[0]); err != nil {
+		return nil, nil, ErrMalformedHash
+	}
+	if key, err = decode(p[1]); err != nil {
+		return nil, nil, ErrMalformedHash
+	}
+	return salt, key, nil
+}
+
+// decode decodes standard base64 with or without padding as imported
+// hashes may have either
+func decode(s string) ([]byte, error) {
+	return encoding.DecodeString(strings.TrimRight(s, "="))
+}
+
+func newSalt() ([]byte, error) {
+	salt := make([]byte, saltSize)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, err
+	}
+	return salt, nil
+}
+
+// Bcrypt hashes with bcrypt
+type Bcrypt struct {
+	Cost int
+}
+
+// Name implements Hasher
+func (Bcrypt) Name() string { return "bcrypt" }
+
+// Method implements Hasher
+func (b Bcrypt) Method() string {
+	return fmt.Sprintf("bcrypt$cost=%d", b.Cost)
+}
+
+// Hash implements Hasher
+func (b Bcrypt) Hash(password string) (string, error) {
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), b.Cost)
+	return string(hash), err
+}
+
+// Verify implements Hasher. The cost is read from the hash.
+func (Bcrypt) Verify(password, hash, params string) (bool, error) {
+	err := bcrypt.CompareHashAndPassword([]byte(hash), []byte(password))
+	if err == bcrypt.ErrMismatchedHashAndPassword {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// bcryptMaxCost bounds the cost of imported bcrypt hashes
+const bcryptMaxCost = 16
+
+// Check implements Checker. The cost is read from the hash.
+func (Bcrypt) Check(hash, params string) error {
+	cost, err := bcrypt.Cost([]byte(hash))
+	if err != nil {
+		return ErrMalformedHash
+	}
+	return checkBounds(cost, bcrypt.MinCost, bcryptMaxCost)
+}
+
+// bcryptMethod returns the method of a bcrypt hash
+func bcryptMethod(hash string) string {
+	cost, err := bcrypt.Cost([]byte(hash))
+	if err != nil {
+		return "bcrypt"
+	}
+	return Bcrypt{Cost: cost}.Method()
+}
+
+// Argon2id hashes with Argon2id as described in RFC 9106
+type Argon2id struct {
+	// Time is the number of passes over memory
+	Time uint32
+	// Memory is the memory used in KiB
+	Memory  uint32
+	Threads uint8
+	KeyLen  uint32
+}
+
+// Name implements Hasher
+func (Argon2id) Name() string { return "argon2id" }
+
+// Method implements Hasher
+func (a Argon2id) Method() string {
+	return fmt.Sprintf("argon2id$m=%d,t=%d,p=%d,l=%d", a.Memory, a.Time, a.Threads, a.KeyLen)
+}
+
+// Hash implements Hasher
+func (a Argon2id) Hash(password string) (string, error) {
+	salt, err := newSalt()
+	if err != nil {
+		return "", err
+	}
+	return saltedHash(salt, argon2.IDKey([]byte(password), salt, a.Time, a.Memory, a.Threads, a.KeyLen)), nil
+}
+
+// Verify implements Hasher
+func (Argon2id) Verify(password, hash, params string) (bool, error) {
+	p, err := parseParams(params, "m", "t", "p", "l")
+	if err != nil {
+		return false, err
+	}
+	salt, key, err := parseSaltedHash(hash)
+	if err != nil {
+		return false, err
+	}
+	k := argon2.IDKey([]byte(password), salt, uint32(p["t"]), uint32(p["m"]), uint8(p["p"]), uint32(p["l"]))
+	return subtle.ConstantTimeCompare(k, key) == 1, nil
+}
+
+// Check implements Checker. Memory is bounded to 1 GiB.
+func (Argon2id) Check(hash, params string) error {
+	p, err := parseParams(params, "m", "t", "p", "l")
+	if err != nil {
+		return err
+	}
+	for _, err := range []error{
+		checkBounds(p["m"], 8*p["p"], 1<<20),
+		checkBounds(p["t"], 1, 16),
+		checkBounds(p["p"], 1, 64),
+		checkBounds(p["l"], 16, 64),
+	} {
+		if err != nil {
+			return err
+		}
+	}
+	return checkSaltedHash(hash, p["l"])
+}
+
+// Scrypt hashes with scrypt
+type Scrypt struct {
+	N, R, P int
+	KeyLen  int
+}
+
+// Name implements Hasher
+func (Scrypt) Name() string { return "scrypt" }
+
+// Method implements Hasher
+func (s Scrypt) Method() string {
+	return fmt.Sprintf("scrypt$n=%d,r=%d,p=%d,l=%d", s.N, s.R, s.P, s.KeyLen)
+}
+
+// Hash implements Hasher
+func (s Scrypt) Hash(password string) (string, error) {
+	salt, err := newSalt()
+	if err != nil {
+		return "", err
+	}
+	key, err := scrypt.Key([]byte(password), salt, s.N, s.R, s.P, s.KeyLen)
+	if err != nil {
+		return "", err
+	}
+	return saltedHash(salt, key), nil
+}
+
+// Verify implements Hasher
+func (Scrypt) Verify(password, hash, params string) (bool, error) {
+	p, err := parseParams(params, "n", "r", "p", "l")
+	if err != nil {
+		return false, err
+	}
+	salt, key, err := parseSaltedHash(hash)
+	if err != nil {
+		return false, err
+	}
+	k, err := scrypt.Key([]byte(password), salt, p["n"], p["r"], p["p"], p["l"])
+	if err != nil {
+		return false, err
+	}
+	return subtle.ConstantTimeCompare(k, key) == 1, nil
+}
+
+// Check implements Checker. Memory, 128·n·r bytes, is bounded to 1 GiB.
+func (Scrypt) Check(hash, params string) error {
+	p, err := parseParams(params, "n", "r", "p", "l")
+	if err != nil {
+		return err
+	}
+	if p["n"] < 2 || p["n"]&(p["n"]-1) != 0 {
+		return ErrMalformedHash
+	}
+	for _, err := range []error{
+		checkBounds(p["n"], 2, 1<<20),
+		checkBounds(p["r"], 1, 32),
+		checkBounds(p["n"]*p["r"], 2, 1<<23),
+		checkBounds(p["p"], 1, 16),
+		checkBounds(p["l"], 16, 64),
+	} {
+		if err != nil {
+			return err
+		}
+	}
+	return checkSaltedHash(hash, p["l"])
+}
+
+// Defaults are the parameters used unless configured otherwise
+var (
+	DefaultBcrypt   = Bcrypt{Cost: bcrypt.DefaultCost}
+	DefaultArgon2id = Argon2id{Time: 3, Memory: 64 * 1024, Threads: 4, KeyLen: 32}
+	DefaultScrypt   = Scrypt{N: 32768, R: 8, P: 1, KeyLen: 32}
+)
+
+// New returns a Registry of the default hashers and legacy verifiers
+// creating hashes with the algorithm named
+func New(name string, legacy ...Verifier) (*Registry, error) {
+	hashers := []Verifier{DefaultBcrypt, DefaultArgon2id, DefaultScrypt}
+	for _, h := range hashers {
+		if h.Name() == name {
+			return NewRegistry(h.(Hasher), append(hashers, legacy...)...), nil
+		}
+	}
+	return nil, fmt.Errorf("password: unsupported hasher %q", name)
+}
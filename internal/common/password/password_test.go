@@ -0,0 +1,122 @@
+package password
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/crypto/bcrypt"
+)
+
+var (
+	testBcrypt   = Bcrypt{Cost: bcrypt.MinCost}
+	testArgon2id = Argon2id{Time: 1, Memory: 1024, Threads: 1, KeyLen: 32}
+	testScrypt   = Scrypt{N: 1024, R: 8, P: 1, KeyLen: 32}
+)
+
+func TestHashers(t *testing.T) {
+	for _, h := range []Hasher{testBcrypt, testArgon2id, testScrypt} {
+		t.Run(h.Name(), func(t *testing.T) {
+			r := NewRegistry(h)
+			hash, method, err := r.Hash("hello1234^")
+			assert.NoError(t, err)
+			assert.Equal(t, h.Method(), method)
+
+			ok, rehash, err := r.Verify("hello1234^", hash, method)
+			assert.NoError(t, err)
+			assert.True(t, ok)
+			assert.False(t, rehash)
+
+			ok, _, err = r.Verify("hello1234", hash, method)
+			assert.NoError(t, err)
+			assert.False(t, ok)
+
+			// Salted
+			other, _, _ := r.Hash("hello1234^")
+			assert.NotEqual(t, hash, other)
+		})
+	}
+}
+
+func TestRehash(t *testing.T) {
+	legacy, _ := bcrypt.GenerateFromPassword([]byte("hello1234^"), bcrypt.MinCost)
+	r := NewRegistry(testArgon2id, testScrypt)
+
+	t.Run("Legacy bcrypt", func(t *testing.T) {
+		ok, rehash, err := r.Verify("hello1234^", string(legacy), "")
+		assert.NoError(t, err)
+		assert.True(t, ok)
+		assert.True(t, rehash)
+	})
+
+	t.Run("Outdated parameters", func(t *testing.T) {
+		old := Argon2id{Time: 1, Memory: 512, Threads: 1, KeyLen: 32}
+		hash, _ := old.Hash("hello1234^")
+		ok, rehash, err := r.Verify("hello1234^", hash, old.Method())
+		assert.NoError(t, err)
+		assert.True(t, ok)
+		assert.True(t, rehash)
+	})
+
+	t.Run("Bcrypt cost", func(t *testing.T) {
+		r := NewRegistry(Bcrypt{Cost: bcrypt.MinCost + 1})
+		_, rehash, _ := r.Verify("hello1234^", string(legacy), "bcrypt$cost=4")
+		assert.True(t, rehash)
+	})
+
+	t.Run("No password", func(t *testing.T) {
+		for _, method := range []string{"", "bcrypt", testArgon2id.Method()} {
+			ok, rehash, err := r.Verify("", "", method)
+			assert.NoError(t, err)
+			assert.False(t, ok)
+			assert.False(t, rehash)
+		}
+	})
+
+	t.Run("Unknown method", func(t *testing.T) {
+		_, _, err := r.Verify("hello1234^", "hash", "md5")
+		assert.Equal(t, ErrUnknownMethod, err)
+	})
+
+	t.Run("Malformed", func(t *testing.T) {
+		_, _, err := r.Verify("hello1234^", "hash", "argon2id$m=1")
+		assert.Equal(t, ErrMalformedHash, err)
+	})
+}
+
+func TestCheck(t *testing.T) {
+	r := NewRegistry(testArgon2id, testScrypt)
+	bc, _ := testBcrypt.Hash("hello1234^")
+	argon, _ := testArgon2id.Hash("hello1234^")
+	sc, _ := testScrypt.Hash("hello1234^")
+
+	for _, tt := range []struct {
+		hash, method string
+		err          error
+	}{
+		{bc, "", nil},
+		{bc, "bcrypt$cost=4", nil},
+		{argon, testArgon2id.Method(), nil},
+		{sc, testScrypt.Method(), nil},
+		{"hash", "", ErrMalformedHash},
+		{"$2a$31$" + bc[7:], "", ErrUnsafeParams},
+		{argon, "argon2id$m=1024,t=1,p=1", ErrMalformedHash},
+		{argon, "argon2id$m=1024,t=1,p=1,l=16", ErrMalformedHash},
+		{argon, "argon2id$m=4294967295,t=1,p=1,l=32", ErrUnsafeParams},
+		{argon, "argon2id$m=1024,t=1000,p=1,l=32", ErrUnsafeParams},
+		{argon, "argon2id$m=1024,t=1,p=255,l=32", ErrUnsafeParams},
+		{sc, "scrypt$n=1000,r=8,p=1,l=32", ErrMalformedHash},
+		{sc, "scrypt$n=1073741824,r=8,p=1,l=32", ErrUnsafeParams},
+		{sc, "scrypt$n=1048576,r=32,p=1,l=32", ErrUnsafeParams},
+		{sc, "md5", ErrUnknownMethod},
+	} {
+		assert.Equal(t, tt.err, r.Check(tt.hash, tt.method), tt.method)
+	}
+}
+
+func TestNew(t *testing.T) {
+	r, err := New("argon2id")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"argon2id", "bcrypt", "scrypt"}, r.Names())
+	_, err = New("md5")
+	assert.Error(t, err)
+}
@@ -0,0 +1,229 @@
+package password
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// Normalization modes applied to passwords before they are checked and
+// hashed
+const (
+	NormalizeNone = "none"
+	NormalizeNFC  = "nfc"
+	NormalizeNFKC = "nfkc"
+)
+
+// Rules reported by Policy.Check
+const (
+	RuleMinLength   = "min_length"
+	RuleMaxLength   = "max_length"
+	RuleLower       = "lower"
+	RuleUpper       = "upper"
+	RuleDigit       = "digit"
+	RuleSymbol      = "symbol"
+	RuleMinClasses  = "min_classes"
+	RuleSubstring   = "substring"
+	RuleMaxRepeated = "max_repeated"
+	RuleMinScore    = "min_score"
+)
+
+// Policy defines the rules passwords must satisfy. Lengths are counted in
+// characters after normalization.
+type Policy struct {
+	MinLength     int  `json:"min_length"`
+	MaxLength     int  `json:"max_length"`
+	RequireLower  bool `json:"require_lower"`
+	RequireUpper  bool `json:"require_upper"`
+	RequireDigit  bool `json:"require_digit"`
+	RequireSymbol bool `json:"require_symbol"`
+	// MinClasses requires characters of this many of lower case, upper
+	// case, digits and symbols
+	MinClasses int `json:"min_classes"`
+	// DisallowPersonal rejects passwords containing the email or names of
+	// the account
+	DisallowPersonal bool `json:"disallow_personal"`
+	// DisallowedSubstrings are rejected regardless of case
+	DisallowedSubstrings []string `json:"disallowed_substrings"`
+	// MinSubstringLength is the shortest part of an email or name which is
+	// rejected
+	MinSubstringLength int `json:"min_substring_length"`
+	// MaxRepeated is the most times a character may repeat consecutively.
+	// Zero does not limit.
+	MaxRepeated int `json:"max_repeated"`
+	// MinScore is the lowest score from Estimate accepted. Zero does not
+	// estimate.
+	MinScore int `json:"min_score"`
+	// Normalization is none, nfc or nfkc
+	Normalization string `json:"normalization"`
+}
+
+// Violation is a rule a password does not satisfy
+type Violation struct {
+	Rule    string
+	Message string
+}
+
+// DefaultPolicy returns the policy used unless configured otherwise
+func DefaultPolicy() Policy {
+	return Policy{
+		MinLength:          8,
+		MaxLength:          64,
+		RequireSymbol:      true,
+		DisallowPersonal:   true,
+		MinSubstringLength: 4,
+		Normalization:      NormalizeNFKC,
+	}
+}
+
+// ParsePolicy decodes a JSON policy. Fields left out keep their default.
+func ParsePolicy(data []byte) (Policy, error) {
+	p := DefaultPolicy()
+	if err := json.Unmarshal(data, &p); err != nil {
+		return p, fmt.Errorf("password: policy: %v", err)
+	}
+	return p, p.Validate()
+}
+
+// Validate checks the policy can be satisfied
+func (p Policy) Validate() error {
+	switch p.Normalization {
+	case "", NormalizeNone, NormalizeNFC, NormalizeNFKC:
+	default:
+		return fmt.Errorf("password: policy: unsupported normalization %q", p.Normalization)
+	}
+	if p.MinLength < 1 || p.MaxLength < p.MinLength {
+		return errors.New("password: policy: min_length must be at least 1 and at most max_length")
+	}
+	if p.MinClasses < 0 || p.MinClasses > 4 {
+		return errors.New("password: policy: min_classes must be between 0 and 4")
+	}
+	if p.MinScore < 0 || p.MinScore > 4 {
+		return errors.New("password: policy: min_score must be between 0 and 4")
+	}
+	return nil
+}
+
+// Normalize returns password in the policy's normalization form
+func (p Policy) Normalize(password string) string {
+	switch p.Normalization {
+	case NormalizeNFC:
+		return norm.NFC.String(password)
+	case NormalizeNFKC:
+		return norm.NFKC.String(password)
+	}
+	return password
+}
+
+// Check returns every rule a normalized password violates. personal holds
+// the account's email and names.
+func (p Policy) Check(password string, personal ...string) []Violation {
+	var v []Violation
+	add := func(rule, msg string, args ...interface{}) {
+		v = append(v, Violation{Rule: rule, Message: fmt.Sprintf(msg, args...)})
+	}
+
+	if n := utf8.RuneCountInString(password); n < p.MinLength {
+		add(RuleMinLength, "Password must be at least %d characters", p.MinLength)
+	} else if n > p.MaxLength {
+		add(RuleMaxLength, "Password must be at most %d characters", p.MaxLength)
+	}
+
+	var lower, upper, digit, symbol bool
+	for _, r := range password {
+		switch {
+		case unicode.IsLower(r):
+			lower = true
+		case unicode.IsUpper(r):
+			upper = true
+		case unicode.IsDigit(r):
+			digit = true
+		case unicode.IsPunct(r) || unicode.IsSymbol(r):
+			symbol = true
+		}
+	}
+	if p.RequireLower && !lower {
+		add(RuleLower, "Password must contain a lower case letter")
+	}
+	if p.RequireUpper && !upper {
+		add(RuleUpper, "Password must contain an upper case letter")
+	}
+	if p.RequireDigit && !digit {
+		add(RuleDigit, "Password must contain a digit")
+	}
+	if p.RequireSymbol && !symbol {
+		add(RuleSymbol, "Password must contain a symbol")
+	}
+	classes := 0
+	for _, c := range []bool{lower, upper, digit, symbol} {
+		if c {
+			classes++
+		}
+	}
+	if classes < p.MinClasses {
+		add(RuleMinClasses, "Password must contain %d of lower case letters, upper case letters, digits and symbols", p.MinClasses)
+	}
+
+	if s := p.disallowed(password, personal); s != "" {
+		add(RuleSubstring, "Password must not contain %q", s)
+	}
+
+	if p.MaxRepeated > 0 && maxRepeated(password) > p.MaxRepeated {
+		add(RuleMaxRepeated, "Password must not repeat a character more than %d times in a row", p.MaxRepeated)
+	}
+
+	if p.MinScore > 0 && Estimate(password, personal...).Score < p.MinScore {
+		add(RuleMinScore, "Password is too easy to guess")
+	}
+	return v
+}
+
+// disallowed returns the first disallowed substring password contains
+func (p Policy) disallowed(password string, personal []string) string {
+	lower := strings.ToLower(password)
+	for _, s := range p.DisallowedSubstrings {
+		if s != "" && strings.Contains(lower, strings.ToLower(s)) {
+			return s
+		}
+	}
+	if !p.DisallowPersonal {
+		return ""
+	}
+	for _, s := range personal {
+		// Check the email's local part and each word of names
+		if i := strings.LastIndex(s, "@"); i >= 0 {
+			s = s[:i]
+		}
+		words := strings.FieldsFunc(strings.ToLower(s), func(r rune) bool {
+			return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+		})
+		for _, w := range words {
+			if utf8.RuneCountInString(w) >= p.MinSubstringLength && strings.Contains(lower, w) {
+				return w
+			}
+		}
+	}
+	return ""
+}
+
+// maxRepeated returns the longest run of a character
+func maxRepeated(s string) int {
+	max, run := 0, 0
+	var last rune = -1
+	for _, r := range s {
+		if r == last {
+			run++
+		} else {
+			run, last = 1, r
+		}
+		if run > max {
+			max = run
+		}
+	}
+	return max
+}
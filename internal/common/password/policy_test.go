@@ -0,0 +1,96 @@
+package password
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func rules(v []Violation) []string {
+	r := []string{}
+	for _, x := range v {
+		r = append(r, x.Rule)
+	}
+	return r
+}
+
+func TestPolicyCheck(t *testing.T) {
+	p := Policy{
+		MinLength:            8,
+		MaxLength:            16,
+		RequireLower:         true,
+		RequireUpper:         true,
+		RequireDigit:         true,
+		RequireSymbol:        true,
+		MinClasses:           4,
+		DisallowPersonal:     true,
+		DisallowedSubstrings: []string{"Acme"},
+		MinSubstringLength:   4,
+		MaxRepeated:          2,
+	}
+
+	tests := []struct {
+		password string
+		personal []string
+		want     []string
+	}{
+		{"Hello1234^", nil, []string{}},
+		{"", nil, []string{RuleMinLength, RuleLower, RuleUpper, RuleDigit, RuleSymbol, RuleMinClasses}},
+		{"Hello1234^Hello1234^", nil, []string{RuleMaxLength}},
+		{"hello1234^", nil, []string{RuleUpper, RuleMinClasses}},
+		{"Hello1234^", []string{"jane.hello@example.com"}, []string{RuleSubstring}},
+		{"Hello1234^", []string{"Jo Hello"}, []string{RuleSubstring}},
+		{"Hey1234^jo", []string{"Jo Hello"}, []string{}},
+		{"myACME123^x", nil, []string{RuleSubstring}},
+		{"Helllo1234^", nil, []string{RuleMaxRepeated}},
+		{"héllo1234^É", nil, []string{}},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, rules(p.Check(tt.password, tt.personal...)), tt.password)
+	}
+
+	t.Run("Min score", func(t *testing.T) {
+		p := Policy{MinLength: 1, MaxLength: 64, MinScore: 3}
+		assert.Equal(t, []string{RuleMinScore}, rules(p.Check("password1")))
+		assert.Equal(t, []string{RuleMinScore}, rules(p.Check("lyra-wongfang", "lyra@example.com", "Wongfang")))
+		assert.Empty(t, p.Check("correcthorsebatterystaple"))
+	})
+
+	t.Run("Personal allowed", func(t *testing.T) {
+		p := p
+		p.DisallowPersonal = false
+		assert.Empty(t, p.Check("Hello1234^", "hello@example.com"))
+	})
+}
+
+func TestPolicyNormalize(t *testing.T) {
+	// "é" precomposed and decomposed, and a fullwidth "A"
+	composed, decomposed, fullwidth := "é", "é", "Ａ"
+
+	assert.Equal(t, decomposed, Policy{Normalization: NormalizeNone}.Normalize(decomposed))
+	assert.Equal(t, composed, Policy{Normalization: NormalizeNFC}.Normalize(decomposed))
+	assert.Equal(t, fullwidth, Policy{Normalization: NormalizeNFC}.Normalize(fullwidth))
+	assert.Equal(t, "A", Policy{Normalization: NormalizeNFKC}.Normalize(fullwidth))
+}
+
+func TestParsePolicy(t *testing.T) {
+	p, err := ParsePolicy([]byte(`{"min_length": 12, "require_upper": true, "max_repeated": 3}`))
+	assert.NoError(t, err)
+	want := DefaultPolicy()
+	want.MinLength = 12
+	want.RequireUpper = true
+	want.MaxRepeated = 3
+	assert.Equal(t, want, p)
+
+	for _, raw := range []string{
+		`{"normalization": "nfd"}`,
+		`{"min_length": 0}`,
+		`{"min_length": 80}`,
+		`{"min_classes": 5}`,
+		`{"min_score": 5}`,
+		`[]`,
+	} {
+		_, err := ParsePolicy([]byte(raw))
+		assert.Error(t, err, raw)
+	}
+}
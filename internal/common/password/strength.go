@@ -0,0 +1,963 @@
+package password
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// Strength is estimated as zxcvbn does: the password is matched against
+// dictionaries and common patterns and the guesses needed for the least
+// guessable sequence of matches, brute forcing what is left between them,
+// is counted.
+
+const (
+	// maxEstimateLength bounds the work done per estimate. Characters past
+	// it are ignored.
+	maxEstimateLength = 100
+	// guessesPerSecond is an attacker guessing offline against a slow hash
+	guessesPerSecond = 1e4
+
+	bruteforceCardinality           = 10
+	minGuessesBeforeGrowingSequence = 10000
+	minSubmatchGuessesSingleChar    = 10
+	minSubmatchGuessesMultiChar     = 50
+	minYearSpace                    = 20
+)
+
+// Strength is an estimate of how hard a password is to guess
+type Strength struct {
+	// Score is 0 to 4, from too guessable to very unguessable
+	Score            int
+	Guesses          float64
+	CrackTimeSeconds float64
+	CrackTimeDisplay string
+	// Warning explains what makes a weak password guessable
+	Warning     string
+	Suggestions []string
+}
+
+// Estimate estimates the strength of password. userInputs such as the
+// account's email and names are treated as a dictionary an attacker would
+// try first.
+func Estimate(password string, userInputs ...string) Strength {
+	pw := []rune(password)
+	if len(pw) > maxEstimateLength {
+		pw = pw[:maxEstimateLength]
+	}
+	dicts := append(append([]dictionary{}, rankedDictionaries...), userDictionary(userInputs))
+
+	guesses, seq := mostGuessable(pw, omnimatch(pw, dicts), dicts)
+	s := Strength{
+		Score:            guessesScore(guesses),
+		Guesses:          guesses,
+		CrackTimeSeconds: guesses / guessesPerSecond,
+	}
+	s.CrackTimeDisplay = displayTime(s.CrackTimeSeconds)
+	s.Warning, s.Suggestions = feedback(s.Score, seq)
+	return s
+}
+
+// Patterns matched in passwords
+const (
+	patternDictionary = "dictionary"
+	patternSpatial    = "spatial"
+	patternRepeat     = "repeat"
+	patternSequence   = "sequence"
+	patternYear       = "year"
+	patternDate       = "date"
+	patternBruteforce = "bruteforce"
+)
+
+// Dictionaries matched against
+const (
+	dictPasswords  = "passwords"
+	dictEnglish    = "english"
+	dictNames      = "names"
+	dictUserInputs = "user_inputs"
+)
+
+// match is a run of the password, from rune i to j inclusive, matching a
+// pattern
+type match struct {
+	pattern string
+	i, j    int
+	token   []rune
+	guesses float64
+
+	dictionary string
+	rank       int
+	reversed   bool
+	l33t       bool
+	turns      int
+	base       []rune
+}
+
+func (m *match) len() int {
+	return m.j - m.i + 1
+}
+
+type dictionary struct {
+	name  string
+	ranks map[string]int
+}
+
+// rankedDictionaries are ranked from the word lists, most common first
+var rankedDictionaries = []dictionary{
+	rankDictionary(dictPasswords, strings.Fields(commonPasswords)),
+	rankDictionary(dictEnglish, strings.Fields(englishWords)),
+	rankDictionary(dictNames, strings.Fields(commonNames)),
+}
+
+func rankDictionary(name string, words []string) dictionary {
+	d := dictionary{name: name, ranks: map[string]int{}}
+	for _, w := range words {
+		w = strings.ToLower(w)
+		if _, ok := d.ranks[w]; !ok {
+			d.ranks[w] = len(d.ranks) + 1
+		}
+	}
+	return d
+}
+
+// userDictionary ranks user inputs and the words they are made of
+func userDictionary(inputs []string) dictionary {
+	var words []string
+	for _, in := range inputs {
+		in = strings.ToLower(strings.TrimSpace(in))
+		if in == "" {
+			continue
+		}
+		words = append(words, in)
+		if i := strings.LastIndex(in, "@"); i >= 0 {
+			words = append(words, in[:i])
+		}
+		words = append(words, strings.FieldsFunc(in, func(r rune) bool {
+			return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+		})...)
+	}
+	return rankDictionary(dictUserInputs, words)
+}
+
+func omnimatch(pw []rune, dicts []dictionary) []*match {
+	var matches []*match
+	matches = append(matches, dictionaryMatches(pw, dicts)...)
+	matches = append(matches, reversedMatches(pw, dicts)...)
+	matches = append(matches, l33tMatches(pw, dicts)...)
+	matches = append(matches, spatialMatches(pw)...)
+	matches = append(matches, repeatMatches(pw, dicts)...)
+	matches = append(matches, sequenceMatches(pw)...)
+	matches = append(matches, yearMatches(pw)...)
+	matches = append(matches, dateMatches(pw)...)
+	return matches
+}
+
+func toLower(pw []rune) []rune {
+	lower := make([]rune, len(pw))
+	for i, r := range pw {
+		lower[i] = unicode.ToLower(r)
+	}
+	return lower
+}
+
+func dictionaryMatches(pw []rune, dicts []dictionary) []*match {
+	var matches []*match
+	lower := toLower(pw)
+	for i := range lower {
+		for j := i; j < len(lower); j++ {
+			word := string(lower[i : j+1])
+			for _, d := range dicts {
+				if rank, ok := d.ranks[word]; ok {
+					matches = append(matches, &match{
+						pattern:    patternDictionary,
+						i:          i,
+						j:          j,
+						dictionary: d.name,
+						rank:       rank,
+						token:      pw[i : j+1],
+						guesses:    float64(rank) * uppercaseVariations(pw[i:j+1]),
+					})
+				}
+			}
+		}
+	}
+	return matches
+}
+
+func reversedMatches(pw []rune, dicts []dictionary) []*match {
+	n := len(pw)
+	rev := make([]rune, n)
+	for i, r := range pw {
+		rev[n-1-i] = r
+	}
+	matches := dictionaryMatches(rev, dicts)
+	for _, m := range matches {
+		m.i, m.j = n-1-m.j, n-1-m.i
+		m.token = pw[m.i : m.j+1]
+		m.reversed = true
+		m.guesses *= 2
+	}
+	return matches
+}
+
+// l33tTable lists the characters commonly substituted for letters
+var l33tTable = map[rune][]rune{
+	'a': {'4', '@'},
+	'b': {'8'},
+	'c': {'(', '{', '[', '<'},
+	'e': {'3'},
+	'g': {'6', '9'},
+	'i': {'1', '!', '|'},
+	'l': {'1', '|', '7'},
+	'o': {'0'},
+	's': {'$', '5'},
+	't': {'+', '7'},
+	'x': {'%'},
+	'z': {'2'},
+}
+
+// maxL33tSubs bounds the substitutions tried for characters standing for
+// more than one letter
+const maxL33tSubs = 64
+
+// l33tSubs returns the substitutions of letters for the l33t characters in
+// pw
+func l33tSubs(pw []rune) []map[rune]rune {
+	letters := map[rune][]rune{}
+	var chars []rune
+	for letter, subs := range l33tTable {
+		for _, c := range subs {
+			if _, ok := letters[c]; !ok && containsRune(pw, c) {
+				chars = append(chars, c)
+			}
+			if containsRune(pw, c) {
+				letters[c] = append(letters[c], letter)
+			}
+		}
+	}
+	// Map iteration is random; keep the result stable
+	sortRunes(chars)
+	for _, c := range chars {
+		sortRunes(letters[c])
+	}
+
+	subs := []map[rune]rune{{}}
+	for _, c := range chars {
+		var next []map[rune]rune
+		for _, sub := range subs {
+			for _, letter := range letters[c] {
+				if len(next) == maxL33tSubs {
+					break
+				}
+				s := map[rune]rune{c: letter}
+				for k, v := range sub {
+					s[k] = v
+				}
+				next = append(next, s)
+			}
+		}
+		subs = next
+	}
+	if len(subs) == 1 && len(subs[0]) == 0 {
+		return nil
+	}
+	return subs
+}
+
+func l33tMatches(pw []rune, dicts []dictionary) []*match {
+	var matches []*match
+	seen := map[string]bool{}
+	for _, sub := range l33tSubs(pw) {
+		translated := make([]rune, len(pw))
+		for i, r := range pw {
+			if letter, ok := sub[r]; ok {
+				translated[i] = letter
+			} else {
+				translated[i] = r
+			}
+		}
+		for _, m := range dictionaryMatches(translated, dicts) {
+			token := pw[m.i : m.j+1]
+			used := map[rune]rune{}
+			for c, letter := range sub {
+				if containsRune(token, c) {
+					used[c] = letter
+				}
+			}
+			// Single characters are left to brute force
+			if len(used) == 0 || m.len() == 1 {
+				continue
+			}
+			key := fmt.Sprintf("%d:%d:%v:%d", m.i, m.j, m.dictionary, m.rank)
+			if seen[key] {
+				continue
+			}
+			seen[key] = true
+			m.l33t = true
+			m.token = token
+			m.guesses = float64(m.rank) * uppercaseVariations(token) * l33tVariations(token, used)
+			matches = append(matches, m)
+		}
+	}
+	return matches
+}
+
+// uppercaseVariations is the number of ways a word could be capitalized as
+// likely as the token
+func uppercaseVariations(token []rune) float64 {
+	var upper, lower int
+	for _, r := range token {
+		switch {
+		case unicode.IsUpper(r):
+			upper++
+		case unicode.IsLower(r):
+			lower++
+		}
+	}
+	n := len(token)
+	switch {
+	case upper == 0:
+		return 1
+	case lower == 0,
+		upper == 1 && unicode.IsUpper(token[0]),
+		upper == 1 && unicode.IsUpper(token[n-1]):
+		return 2
+	}
+	return variations(upper, lower)
+}
+
+// l33tVariations is the number of ways letters could be substituted as
+// likely as the token
+func l33tVariations(token []rune, sub map[rune]rune) float64 {
+	lower := toLower(token)
+	v := 1.0
+	for c, letter := range sub {
+		var subbed, unsubbed int
+		for _, r := range lower {
+			switch r {
+			case c:
+				subbed++
+			case letter:
+				unsubbed++
+			}
+		}
+		if subbed == 0 || unsubbed == 0 {
+			v *= 2
+		} else {
+			v *= variations(subbed, unsubbed)
+		}
+	}
+	return v
+}
+
+// variations sums the ways to pick up to min(a, b) of a+b characters
+func variations(a, b int) float64 {
+	min := a
+	if b < min {
+		min = b
+	}
+	var v float64
+	for i := 1; i <= min; i++ {
+		v += binomial(a+b, i)
+	}
+	return v
+}
+
+// keyboard is the position of each key of a QWERTY keyboard
+type keyboard map[rune]struct {
+	row, col int
+	shifted  bool
+}
+
+var qwerty = func() keyboard {
+	k := keyboard{}
+	rows := []string{"`1234567890-=", "qwertyuiop[]\\", "asdfghjkl;'", "zxcvbnm,./"}
+	shifted := []string{"~!@#$%^&*()_+", "QWERTYUIOP{}|", "ASDFGHJKL:\"", "ZXCVBNM<>?"}
+	for r := range rows {
+		for c, key := range []rune(rows[r]) {
+			k[key] = struct {
+				row, col int
+				shifted  bool
+			}{r, c, false}
+		}
+		for c, key := range []rune(shifted[r]) {
+			k[key] = struct {
+				row, col int
+				shifted  bool
+			}{r, c, true}
+		}
+	}
+	return k
+}()
+
+// direction returns the direction from key a to an adjacent key b, or -1 if
+// they are not adjacent. Each row is offset half a key right of the one
+// above.
+func (k keyboard) direction(a, b rune) int {
+	pa, ok := k[a]
+	if !ok {
+		return -1
+	}
+	pb, ok := k[b]
+	if !ok {
+		return -1
+	}
+	dr, dc := pb.row-pa.row, pb.col-pa.col
+	switch {
+	case dr == 0 && dc == -1:
+		return 0
+	case dr == 0 && dc == 1:
+		return 1
+	case dr == -1 && dc == 0:
+		return 2
+	case dr == -1 && dc == 1:
+		return 3
+	case dr == 1 && dc == -1:
+		return 4
+	case dr == 1 && dc == 0:
+		return 5
+	}
+	return -1
+}
+
+// averageDegree is the average number of keys adjacent to a key
+func (k keyboard) averageDegree() float64 {
+	var total int
+	for a := range k {
+		for b := range k {
+			if k[b].shifted == k[a].shifted && k.direction(a, b) >= 0 {
+				total++
+			}
+		}
+	}
+	return float64(total) / float64(len(k))
+}
+
+var qwertyDegree = qwerty.averageDegree()
+
+func spatialMatches(pw []rune) []*match {
+	var matches []*match
+	for i := 0; i < len(pw)-2; {
+		j, turns, last := i, 0, -1
+		for j+1 < len(pw) {
+			d := qwerty.direction(pw[j], pw[j+1])
+			if d < 0 {
+				break
+			}
+			if d != last {
+				turns++
+				last = d
+			}
+			j++
+		}
+		if j-i+1 >= 3 {
+			var shifted int
+			for _, r := range pw[i : j+1] {
+				if qwerty[r].shifted {
+					shifted++
+				}
+			}
+			matches = append(matches, &match{
+				pattern: patternSpatial,
+				i:       i,
+				j:       j,
+				turns:   turns,
+				guesses: spatialGuesses(j-i+1, turns, shifted),
+			})
+		}
+		if j > i {
+			i = j
+		} else {
+			i++
+		}
+	}
+	return matches
+}
+
+func spatialGuesses(length, turns, shifted int) float64 {
+	s := float64(len(qwerty))
+	var g float64
+	for i := 2; i <= length; i++ {
+		for j := 1; j <= turns && j <= i-1; j++ {
+			g += binomial(i-1, j-1) * s * math.Pow(qwertyDegree, float64(j))
+		}
+	}
+	switch unshifted := length - shifted; {
+	case shifted == 0:
+	case unshifted == 0:
+		g *= 2
+	default:
+		g *= variations(shifted, unshifted)
+	}
+	return g
+}
+
+func repeatMatches(pw []rune, dicts []dictionary) []*match {
+	var matches []*match
+	for i := 0; i < len(pw); {
+		var base, count int
+		for b := 1; i+2*b <= len(pw); b++ {
+			k := 1
+			for i+(k+1)*b <= len(pw) && string(pw[i+k*b:i+(k+1)*b]) == string(pw[i:i+b]) {
+				k++
+			}
+			if k >= 2 && k*b > base*count {
+				base, count = b, k
+			}
+		}
+		if count == 0 {
+			i++
+			continue
+		}
+		b := pw[i : i+base]
+		g, _ := mostGuessable(b, omnimatch(b, dicts), dicts)
+		matches = append(matches, &match{
+			pattern: patternRepeat,
+			i:       i,
+			j:       i + base*count - 1,
+			base:    b,
+			guesses: g * float64(count),
+		})
+		i += base * count
+	}
+	return matches
+}
+
+// maxSequenceDelta is the largest step between characters of a sequence
+const maxSequenceDelta = 5
+
+func sequenceMatches(pw []rune) []*match {
+	var matches []*match
+	for i := 0; i+2 < len(pw); {
+		delta := pw[i+1] - pw[i]
+		j := i + 1
+		for j+1 < len(pw) && pw[j+1]-pw[j] == delta {
+			j++
+		}
+		if j-i+1 >= 3 && delta != 0 && delta >= -maxSequenceDelta && delta <= maxSequenceDelta {
+			var base float64
+			switch first := pw[i]; {
+			case strings.ContainsRune("aAzZ019", first):
+				base = 4
+			case unicode.IsDigit(first):
+				base = 10
+			default:
+				base = 26
+			}
+			if delta < 0 {
+				base *= 2
+			}
+			matches = append(matches, &match{
+				pattern: patternSequence,
+				i:       i,
+				j:       j,
+				guesses: base * float64(j-i+1),
+			})
+		}
+		i = j
+	}
+	return matches
+}
+
+func yearSpace(year int) float64 {
+	return math.Max(math.Abs(float64(year-time.Now().Year())), minYearSpace)
+}
+
+func yearMatches(pw []rune) []*match {
+	var matches []*match
+	for i := 0; i+4 <= len(pw); i++ {
+		token := string(pw[i : i+4])
+		if !isDigits(token) || (token[:2] != "19" && token[:2] != "20") {
+			continue
+		}
+		year, _ := strconv.Atoi(token)
+		matches = append(matches, &match{
+			pattern: patternYear,
+			i:       i,
+			j:       i + 3,
+			guesses: yearSpace(year),
+		})
+	}
+	return matches
+}
+
+func dateMatches(pw []rune) []*match {
+	var matches []*match
+	for i := range pw {
+		for j := i + 3; j < len(pw) && j < i+10; j++ {
+			year, separated, ok := parseDate(string(pw[i : j+1]))
+			if !ok {
+				continue
+			}
+			g := yearSpace(year) * 365
+			if separated {
+				g *= 4
+			}
+			matches = append(matches, &match{
+				pattern: patternDate,
+				i:       i,
+				j:       j,
+				guesses: g,
+			})
+		}
+	}
+	return matches
+}
+
+// parseDate returns the year of a date of digits optionally separated by
+// one of " /\_.-"
+func parseDate(token string) (int, bool, bool) {
+	if isDigits(token) {
+		if len(token) > 8 {
+			return 0, false, false
+		}
+		for a := 1; a < len(token)-1; a++ {
+			for b := a + 1; b < len(token); b++ {
+				if year, ok := dateYear(token[:a], token[a:b], token[b:]); ok {
+					return year, false, true
+				}
+			}
+		}
+		return 0, false, false
+	}
+	i := strings.IndexFunc(token, func(r rune) bool { return r < '0' || r > '9' })
+	if !strings.ContainsRune(` /\_.-`, rune(token[i])) {
+		return 0, false, false
+	}
+	parts := strings.Split(token, token[i:i+1])
+	if len(parts) != 3 || !isDigits(parts[0]+parts[1]+parts[2]) {
+		return 0, false, false
+	}
+	year, ok := dateYear(parts[0], parts[1], parts[2])
+	return year, true, ok
+}
+
+// dateYear returns the year of a day, month and year given in either order
+func dateYear(a, b, c string) (int, bool) {
+	dayMonth := func(x, y string) bool {
+		if x == "" || y == "" || len(x) > 2 || len(y) > 2 {
+			return false
+		}
+		d, _ := strconv.Atoi(x)
+		m, _ := strconv.Atoi(y)
+		return (d >= 1 && d <= 31 && m >= 1 && m <= 12) || (m >= 1 && m <= 31 && d >= 1 && d <= 12)
+	}
+	if y, ok := parseYear(c); ok && dayMonth(a, b) {
+		return y, true
+	}
+	if y, ok := parseYear(a); ok && dayMonth(b, c) {
+		return y, true
+	}
+	return 0, false
+}
+
+func parseYear(s string) (int, bool) {
+	y, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, false
+	}
+	switch len(s) {
+	case 2:
+		if y > 50 {
+			return 1900 + y, true
+		}
+		return 2000 + y, true
+	case 4:
+		return y, y >= 1000 && y <= 2050
+	}
+	return 0, false
+}
+
+// mostGuessable returns the guesses needed for the least guessable sequence
+// of matches covering pw, brute forcing runs no match covers, and the
+// sequence
+func mostGuessable(pw []rune, matches []*match, dicts []dictionary) (float64, []*match) {
+	n := len(pw)
+	if n == 0 {
+		return 1, nil
+	}
+
+	byEnd := make([][]*match, n)
+	for _, m := range matches {
+		g := m.guesses
+		if m.len() < n {
+			if m.len() == 1 {
+				g = math.Max(g, minSubmatchGuessesSingleChar)
+			} else {
+				g = math.Max(g, minSubmatchGuessesMultiChar)
+			}
+		}
+		m.guesses = math.Max(g, 1)
+		byEnd[m.j] = append(byEnd[m.j], m)
+	}
+
+	// best[k][l] is the best sequence of l matches covering pw[:k+1]
+	type step struct {
+		m  *match
+		pi float64
+		g  float64
+	}
+	best := make([][]*step, n)
+	for k := range best {
+		best[k] = make([]*step, n+1)
+	}
+	update := func(m *match, l int) {
+		pi := m.guesses
+		if l > 1 {
+			pi *= best[m.i-1][l-1].pi
+		}
+		g := factorial(l)*pi + math.Pow(minGuessesBeforeGrowingSequence, float64(l-1))
+		for cl := 1; cl <= l; cl++ {
+			if s := best[m.j][cl]; s != nil && s.g <= g {
+				return
+			}
+		}
+		best[m.j][l] = &step{m: m, pi: pi, g: g}
+	}
+	bruteforce := func(i, j int) *match {
+		g := math.Pow(bruteforceCardinality, float64(j-i+1))
+		switch {
+		case j-i+1 == n:
+		case i == j:
+			g = math.Max(g, minSubmatchGuessesSingleChar+1)
+		default:
+			g = math.Max(g, minSubmatchGuessesMultiChar+1)
+		}
+		return &match{pattern: patternBruteforce, i: i, j: j, guesses: g}
+	}
+
+	for k := 0; k < n; k++ {
+		for _, m := range byEnd[k] {
+			if m.i == 0 {
+				update(m, 1)
+				continue
+			}
+			for l, s := range best[m.i-1] {
+				if s != nil {
+					update(m, l+1)
+				}
+			}
+		}
+		update(bruteforce(0, k), 1)
+		for i := 1; i <= k; i++ {
+			for l, s := range best[i-1] {
+				// Adjacent brute force runs are one run
+				if s != nil && s.m.pattern != patternBruteforce {
+					update(bruteforce(i, k), l+1)
+				}
+			}
+		}
+	}
+
+	l := 0
+	for cl, s := range best[n-1] {
+		if s != nil && (l == 0 || s.g < best[n-1][l].g) {
+			l = cl
+		}
+	}
+	guesses := best[n-1][l].g
+	seq := make([]*match, l)
+	for k := n - 1; k >= 0; l-- {
+		m := best[k][l].m
+		seq[l-1] = m
+		k = m.i - 1
+	}
+	return guesses, seq
+}
+
+func guessesScore(guesses float64) int {
+	// Guesses just over a threshold are not worth a higher score
+	const delta = 5
+	switch {
+	case guesses < 1e3+delta:
+		return 0
+	case guesses < 1e6+delta:
+		return 1
+	case guesses < 1e8+delta:
+		return 2
+	case guesses < 1e10+delta:
+		return 3
+	}
+	return 4
+}
+
+func displayTime(seconds float64) string {
+	const (
+		minute  = 60
+		hour    = minute * 60
+		day     = hour * 24
+		month   = day * 31
+		year    = month * 12
+		century = year * 100
+	)
+	units := func(n float64, unit string) string {
+		n = math.Round(n)
+		if n == 1 {
+			return "1 " + unit
+		}
+		return fmt.Sprintf("%.0f %vs", n, unit)
+	}
+	switch {
+	case seconds < 1:
+		return "less than a second"
+	case seconds < minute:
+		return units(seconds, "second")
+	case seconds < hour:
+		return units(seconds/minute, "minute")
+	case seconds < day:
+		return units(seconds/hour, "hour")
+	case seconds < month:
+		return units(seconds/day, "day")
+	case seconds < year:
+		return units(seconds/month, "month")
+	case seconds < century:
+		return units(seconds/year, "year")
+	}
+	return "centuries"
+}
+
+func feedback(score int, seq []*match) (string, []string) {
+	if len(seq) == 0 {
+		return "", []string{
+			"Use a few words, avoid common phrases",
+			"No need for symbols, digits, or uppercase letters",
+		}
+	}
+	if score > 2 {
+		return "", nil
+	}
+	longest := seq[0]
+	for _, m := range seq[1:] {
+		if m.len() > longest.len() {
+			longest = m
+		}
+	}
+	warning, suggestions := matchFeedback(longest, len(seq) == 1)
+	return warning, append([]string{"Add another word or two. Uncommon words are better."}, suggestions...)
+}
+
+func matchFeedback(m *match, sole bool) (string, []string) {
+	switch m.pattern {
+	case patternDictionary:
+		return dictionaryFeedback(m, sole)
+	case patternSpatial:
+		warning := "Short keyboard patterns are easy to guess"
+		if m.turns == 1 {
+			warning = "Straight rows of keys are easy to guess"
+		}
+		return warning, []string{"Use a longer keyboard pattern with more turns"}
+	case patternRepeat:
+		warning := `Repeats like "abcabcabc" are only slightly harder to guess than "abc"`
+		if len(m.base) == 1 {
+			warning = `Repeats like "aaa" are easy to guess`
+		}
+		return warning, []string{"Avoid repeated words and characters"}
+	case patternSequence:
+		return "Sequences like abc or 6543 are easy to guess", []string{"Avoid sequences"}
+	case patternYear:
+		return "Recent years are easy to guess", []string{
+			"Avoid recent years",
+			"Avoid years that are associated with you",
+		}
+	case patternDate:
+		return "Dates are often easy to guess", []string{"Avoid dates and years that are associated with you"}
+	}
+	return "", nil
+}
+
+func dictionaryFeedback(m *match, sole bool) (string, []string) {
+	var warning string
+	switch m.dictionary {
+	case dictPasswords:
+		switch {
+		case sole && !m.l33t && !m.reversed && m.rank <= 10:
+			warning = "This is a top-10 common password"
+		case sole && !m.l33t && !m.reversed && m.rank <= 100:
+			warning = "This is a top-100 common password"
+		case sole && !m.l33t && !m.reversed:
+			warning = "This is a very common password"
+		case math.Log10(m.guesses) <= 4:
+			warning = "This is similar to a commonly used password"
+		}
+	case dictEnglish:
+		if sole {
+			warning = "A word by itself is easy to guess"
+		}
+	case dictNames:
+		warning = "Common names and surnames are easy to guess"
+		if sole {
+			warning = "Names and surnames by themselves are easy to guess"
+		}
+	case dictUserInputs:
+		warning = "Avoid using your email or name"
+	}
+
+	var suggestions []string
+	var upper, lower int
+	for _, r := range m.token {
+		switch {
+		case unicode.IsUpper(r):
+			upper++
+		case unicode.IsLower(r):
+			lower++
+		}
+	}
+	switch {
+	case upper > 0 && lower == 0:
+		suggestions = append(suggestions, "All-uppercase is almost as easy to guess as all-lowercase")
+	case upper == 1 && unicode.IsUpper(m.token[0]):
+		suggestions = append(suggestions, "Capitalization doesn't help very much")
+	}
+	if m.reversed {
+		suggestions = append(suggestions, "Reversed words aren't much harder to guess")
+	}
+	if m.l33t {
+		suggestions = append(suggestions, "Predictable substitutions like '@' instead of 'a' don't help very much")
+	}
+	return warning, suggestions
+}
+
+func factorial(n int) float64 {
+	f := 1.0
+	for i := 2; i <= n; i++ {
+		f *= float64(i)
+	}
+	return f
+}
+
+func binomial(n, k int) float64 {
+	if k < 0 || k > n {
+		return 0
+	}
+	r := 1.0
+	for i := 1; i <= k; i++ {
+		r = r * float64(n-k+i) / float64(i)
+	}
+	return r
+}
+
+func isDigits(s string) bool {
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return s != ""
+}
+
+func containsRune(rs []rune, r rune) bool {
+	for _, x := range rs {
+		if x == r {
+			return true
+		}
+	}
+	return false
+}
+
+func sortRunes(rs []rune) {
+	sort.Slice(rs, func(i, j int) bool { return rs[i] < rs[j] })
+}
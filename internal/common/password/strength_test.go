@@ -0,0 +1,67 @@
+package password
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEstimate(t *testing.T) {
+	tests := []struct {
+		password string
+		score    int
+		warning  string
+	}{
+		{"password", 0, "This is a top-10 common password"},
+		{"p@ssw0rd", 0, "This is similar to a commonly used password"},
+		{"drowssap", 0, "This is similar to a commonly used password"},
+		{"abcdefgh", 0, "Sequences like abc or 6543 are easy to guess"},
+		{"aaaaaaaaaa", 0, `Repeats like "aaa" are easy to guess`},
+		{"1990", 0, "Recent years are easy to guess"},
+		{"13/05/1990", 1, "Dates are often easy to guess"},
+		{"zxcvfdsa", 1, "Short keyboard patterns are easy to guess"},
+		{"correcthorsebatterystaple", 4, ""},
+		{"k3#Vq9!zLp2@wX", 4, ""},
+	}
+	for _, tt := range tests {
+		s := Estimate(tt.password)
+		assert.Equal(t, tt.score, s.Score, tt.password)
+		assert.Equal(t, tt.warning, s.Warning, tt.password)
+		if tt.score <= 2 {
+			assert.NotEmpty(t, s.Suggestions, tt.password)
+		}
+	}
+
+	t.Run("Empty", func(t *testing.T) {
+		s := Estimate("")
+		assert.Equal(t, 0, s.Score)
+		assert.Len(t, s.Suggestions, 2)
+	})
+
+	t.Run("User inputs", func(t *testing.T) {
+		assert.Equal(t, 4, Estimate("lyrawongfang").Score)
+		s := Estimate("lyrawongfang", "lyra@example.com", "Lyra", "Wongfang")
+		assert.Equal(t, 1, s.Score)
+		assert.Equal(t, "Avoid using your email or name", s.Warning)
+	})
+
+	t.Run("Capitalization", func(t *testing.T) {
+		s := Estimate("Monkey")
+		assert.Contains(t, s.Suggestions, "Capitalization doesn't help very much")
+		assert.True(t, s.Guesses > Estimate("monkey").Guesses)
+	})
+}
+
+func TestDisplayTime(t *testing.T) {
+	for seconds, want := range map[float64]string{
+		0.5:      "less than a second",
+		1:        "1 second",
+		150:      "3 minutes",
+		86400:    "1 day",
+		3.2e8:    "10 years",
+		3.2e10:   "centuries",
+		3 * 3600: "3 hours",
+	} {
+		assert.Equal(t, want, displayTime(seconds))
+	}
+}
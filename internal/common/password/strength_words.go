@@ -0,0 +1,145 @@
+package password
+
+// Word lists ranked by Estimate, most common first. The lists are short
+// samples of the frequency lists zxcvbn ships, enough to catch the words
+// and passwords attackers try first.
+
+const commonPasswords = `
+123456 password 12345678 qwerty 123456789 12345 1234 111111 1234567 dragon
+123123 baseball abc123 football monkey letmein 696969 shadow master 666666
+qwertyuiop 123321 mustang 1234567890 michael 654321 pussy superman 1qaz2wsx 7777777
+fuckyou 121212 000000 qazwsx 123qwe killer trustno1 jordan jennifer zxcvbnm
+asdfgh hunter buster soccer harley batman andrew tigger sunshine iloveyou
+fuckme 2000 charlie robert thomas hockey ranger daniel starwars klaster
+112233 george asshole computer michelle jessica pepper 1111 zxcvbn 555555
+11111111 131313 freedom 777777 pass fuck maggie 159753 aaaaaa ginger
+princess joshua cheese amanda summer love ashley 6969 nicole chelsea
+biteme matthew access yankees 987654321 dallas austin thunder taylor matrix
+william corvette hello martin heather secret fucker merlin diamond 1234qwer
+gfhjkm hammer silver 222222 88888888 anthony justin test bailey q1w2e3r4t5
+patrick internet scooter orange 11111 golfer cookie richard samantha bigdog
+guitar jackson whatever mickey chicken sparky snoopy maverick phoenix camaro
+sexy peanut morgan welcome falcon cowboy ferrari samsung andrea smokey
+steelers joseph mercedes dakota arsenal eagles melissa boomer booboo spider
+nascar monster tigers yellow xxxxxx 123123123 gateway marina diablo bulldog
+qwer1234 compaq purple hardcore banana junior hannah 123654 porsche lakers
+iceman money cowboys 987654 london tennis 999999 ncc1701 coffee scooby
+0000 miller boston q1w2e3r4 fuckoff brandon yamaha chester mother forever
+johnny edward 333333 oliver redsox player nikita knight fender barney
+midnight please brandy chicago badboy iwantu slayer rangers charles angel
+flower bigdaddy rabbit wizard bigdick jasper enter rachel chris steven
+winner adidas victoria natasha 1q2w3e4r jasmine winter prince panties
+marine ghbdtn fishing cocacola casper james 232323 raiders 888888 marlboro
+gandalf asdfasdf crystal 87654321 12344321 sexsex golden blowme bigtits
+8675309 panther lauren angela bitch spanky thx1138 angels madison winston
+shannon mike toyota blowjob jordan23 canada sophie apples dick tiger
+razz 123abc pokemon qazxsw 55555 qwaszx muffin johnson murphy cooper
+jonathan liverpoo david danielle 159357 jackie 1990 123456a 789456 turtle
+horny abcd1234 scorpion qazwsxedc 101010 butter carlos password1 dennis
+slipknot qwerty123 booger asdf 1991 black startrek 12341234 cameron
+newyork rainbow nathan john 1992 rocket viking redskins butthead asdfghjkl
+1212 sierra peaches gemini doctor wilson sandra helpme qwertyui victor
+florida dolphin pookie captain tucker blue liverpool theman bandit dolphins
+maddog packers jaguar lovers nicholas united tiffany maxwell zzzzzz nirvana
+jeremy suckit stupid porn monica elephant giants jackass hotdog rosebud
+success debbie mountain 444444 xxxxxxxx warrior 1q2w3e4r5t q1w2e3 123456q
+albert metallic lucky azerty 7777 shithead alex bond007 alexis 1111111
+samson 5150 willie scorpio bonnie gators benjamin voodoo driver dexter
+2112 jason calvin freddy 212121 creative 12345a sydney rush2112 1989
+asdfghjk red123 bubba 4815162342 passw0rd trouble gunner happy fucking
+gordon legend jessie stella qwert eminem arthur apple nissan bullshit bear
+america 1qazxsw2 nothing parker 4444 rebecca qweqwe garfield 01012011
+beavis 69696969 jack asdasd december 2222 102030 252525 11223344 magic
+apollo skippy 315475 girls kitten golf copper braves shelby godzilla
+beaver fred tomcat august buddy airborne 1993 1988 lifehack qqqqqq brooklyn
+animal platinum phantom online xavier darkness blink182 power fish green
+789456123 voyager police travis 12qwaszx heaven snowball lover abcdef
+00000 pakistan 007007 walter playboy blazer cricket sniper hooters donkey
+willow loveme saturn therock redwings bigboy pumpkin trinity williams
+tinkerbell 5555 nicholas qwerty1 letmein1 iloveyou1 monkey1 dragon1 sunshine1
+`
+
+const englishWords = `
+you the to it not that and of what is in me this know my your have for
+do be on no can we all was just he are with so but don get like here
+right there up out go if about got now yeah one him what's see how come
+her well want think they want let's time been could look from back good
+she would when will oh going them tell did hey then really why where
+something as an yes going say take us man make some more never need
+who over way thank little down love any because very off or didn't
+should people life things mean had were two sure thing help okay those
+much only still night thought better first doing work home give old
+money girl day new great call mother father made nothing lot name
+dead last feel long leave world boy live away kind place before always
+friend talk even own big night house believe around family god ever
+school baby heart head problem stay might life wife hand together
+guess sorry another dear remember again care find every next death
+door part everything alright after morning real nice trying until
+mind today wait word maybe happy other fine enough years kill hell
+without name brother blood hard body light water fire power dream
+sister king queen black white green blue red yellow orange purple
+pink silver gold summer winter spring autumn sun moon star sky earth
+city country music dance party game play ball team club horse dog
+cat bird fish tiger lion bear wolf eagle dragon monkey snake rabbit
+apple banana cherry lemon orange peach cookie candy sugar honey coffee
+chocolate pizza butter cheese bread secret magic angel devil heaven
+hell ghost shadow storm thunder rain snow wind ocean river mountain
+forest island garden flower rose lily daisy tree stone rock diamond
+crystal pearl ruby emerald jewel crown castle knight sword shield
+warrior hunter soldier captain doctor teacher master lady lucky
+happy funny crazy sweet pretty beautiful cool super hot cold fast slow
+strong weak smart wild free young old early late open close correct
+horse battery staple table chair window phone paper pencil letter
+number computer internet email account password login user admin system
+network server welcome hello goodbye please thanks sorry yes ok
+monday tuesday wednesday thursday friday saturday sunday january
+february march april may june july august september october november
+december school college university student class lesson office
+company business market bank money credit card price shop store
+street road car truck train plane ship boat bike engine wheel driver
+football soccer baseball basketball hockey tennis golf boxing racing
+movie film video picture camera radio television story book poem song
+guitar piano drum singer artist actor player winner champion hero
+freedom peace justice liberty victory glory honor faith hope trust
+`
+
+const commonNames = `
+smith johnson williams jones brown davis miller wilson moore taylor
+anderson thomas jackson white harris martin thompson garcia martinez
+robinson clark rodriguez lewis lee walker hall allen young hernandez
+king wright lopez hill scott green adams baker gonzalez nelson carter
+mitchell perez roberts turner phillips campbell parker evans edwards
+collins stewart sanchez morris rogers reed cook morgan bell murphy
+bailey rivera cooper richardson cox howard ward torres peterson gray
+ramirez james watson brooks kelly sanders price bennett wood barnes
+ross henderson coleman jenkins perry powell long patterson hughes
+flores washington butler simmons foster gonzales bryant alexander
+russell griffin diaz hayes wong chen wang li zhang liu yang huang
+mary patricia linda barbara elizabeth jennifer maria susan margaret
+dorothy lisa nancy karen betty helen sandra donna carol ruth sharon
+michelle laura sarah kimberly deborah jessica shirley cynthia angela
+melissa brenda amy anna rebecca virginia kathleen pamela martha debra
+amanda stephanie carolyn christine marie janet catherine frances ann
+joyce diane alice julie heather teresa doris gloria evelyn jean cheryl
+mildred katherine joan ashley judith rose janice kelly nicole judy
+christina kathy theresa beverly denise tammy irene jane lori rachel
+marilyn andrea kathryn louise sara anne jacqueline wanda bonnie julia
+ruby lois tina phyllis norma paula diana annie lillian emily robin
+james john robert michael william david richard charles joseph thomas
+christopher daniel paul mark donald george kenneth steven edward brian
+ronald anthony kevin jason matthew gary timothy jose larry jeffrey
+frank scott eric stephen andrew raymond gregory joshua jerry dennis
+walter patrick peter harold douglas henry carl arthur ryan roger joe
+juan jack albert jonathan justin terry gerald keith samuel willie
+ralph lawrence nicholas roy benjamin bruce brandon adam harry fred
+wayne billy steve louis jeremy aaron randy howard eugene carlos russell
+bobby victor martin ernest phillip todd jesse craig alan shawn clarence
+sean philip chris johnny earl jimmy antonio danny bryan tony luis mike
+stanley leonard nathan dale manuel rodney curtis norman allen marvin
+vincent glenn jeffery travis jeff chad jacob lee melvin alfred kyle
+francis bradley jesus herbert frederick ray joel edwin don eddie ricky
+troy randall barry alexander bernard mario leroy francisco marcus
+micheal theodore clifford miguel oscar jay jim tom calvin alex jon
+isaiah noah liam oliver elijah lucas mason logan ethan aiden emma olivia
+ava sophia isabella mia charlotte amelia harper evelyn abigail
+`
@@ -0,0 +1,48 @@
+package totp
+
+import (
+	"encoding/base32"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// RFC 6238 Appendix B test vectors for SHA1 truncated to 6 digits
+func TestCode(t *testing.T) {
+	secret := base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString([]byte("12345678901234567890"))
+	vectors := map[int64]string{
+		59:          "287082",
+		1111111109:  "081804",
+		1111111111:  "050471",
+		1234567890:  "005924",
+		2000000000:  "279037",
+		20000000000: "353130",
+	}
+	for ts, expected := range vectors {
+		code, err := Code(secret, Step(time.Unix(ts, 0)))
+		assert.NoError(t, err)
+		assert.Equal(t, expected, code, "time %v", ts)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	secret, err := GenerateSecret()
+	assert.NoError(t, err)
+
+	now := time.Now()
+	code, _ := Code(secret, Step(now))
+
+	step, ok := Validate(secret, code, now)
+	assert.True(t, ok)
+	assert.Equal(t, Step(now), step)
+
+	_, ok = Validate(secret, code, now.Add(Period))
+	assert.True(t, ok, "previous period should be accepted")
+
+	_, ok = Validate(secret, code, now.Add(3*Period))
+	assert.False(t, ok, "stale code should be rejected")
+
+	_, ok = Validate(secret, "12345", now)
+	assert.False(t, ok)
+}
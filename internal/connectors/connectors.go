@@ -0,0 +1,189 @@
+// Package connectors holds the registry of upstream OpenID Connect issuers
+// accounts can sign in with.
+package connectors
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/isaiahwong/accounts-go/internal/oidc"
+)
+
+// ErrNotFound is returned for connector ids that are not configured
+var ErrNotFound = errors.New("connectors: connector not found")
+
+// ClaimMapping names the ID token claims holding each profile attribute.
+// Empty fields use the standard claim.
+type ClaimMapping struct {
+	Email         string `json:"email"`
+	EmailVerified string `json:"email_verified"`
+	Name          string `json:"name"`
+	GivenName     string `json:"given_name"`
+	FamilyName    string `json:"family_name"`
+	Picture       string `json:"picture"`
+}
+
+// Config defines a connector
+type Config struct {
+	// ID identifies the connector in requests
+	ID           string       `json:"id"`
+	Name         string       `json:"name"`
+	DiscoveryURL string       `json:"discovery_url"`
+	ClientID     string       `json:"client_id"`
+	ClientSecret string       `json:"client_secret"`
+	RedirectURL  string       `json:"redirect_url"`
+	Scopes       []string     `json:"scopes"`
+	Claims       ClaimMapping `json:"claims"`
+	// TrustEmail treats the email claim as verified for issuers which do
+	// not send email_verified
+	TrustEmail bool `json:"trust_email"`
+}
+
+// Identity is the account asserted by an upstream issuer
+type Identity struct {
+	Connector     string
+	Issuer        string
+	Subject       string
+	Email         string
+	EmailVerified bool
+	Name          string
+	GivenName     string
+	FamilyName    string
+	Picture       string
+}
+
+// Connector signs in with an upstream issuer
+type Connector struct {
+	config Config
+
+	mu       sync.Mutex
+	provider *oidc.Provider
+}
+
+// Registry holds the configured connectors
+type Registry struct {
+	connectors map[string]*Connector
+}
+
+// Parse decodes a JSON list of connector configs
+func Parse(data []byte) ([]Config, error) {
+	var configs []Config
+	if err := json.Unmarshal(data, &configs); err != nil {
+		return nil, fmt.Errorf("connectors: %v", err)
+	}
+	return configs, nil
+}
+
+// NewRegistry returns a Registry for configs. Issuers are discovered on
+// first use.
+func NewRegistry(configs []Config) (*Registry, error) {
+	r := &Registry{connectors: map[string]*Connector{}}
+	for _, c := range configs {
+		if c.ID == "" || c.DiscoveryURL == "" || c.ClientID == "" {
+			return nil, fmt.Errorf("connectors: %q requires id, discovery_url and client_id", c.ID)
+		}
+		if _, ok := r.connectors[c.ID]; ok {
+			return nil, fmt.Errorf("connectors: duplicate id %q", c.ID)
+		}
+		r.connectors[c.ID] = &Connector{config: c}
+	}
+	return r, nil
+}
+
+// Get returns the connector for id
+func (r *Registry) Get(id string) (*Connector, error) {
+	if r == nil {
+		return nil, ErrNotFound
+	}
+	c, ok := r.connectors[id]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	return c, nil
+}
+
+// IDs returns the configured connector ids in order
+func (r *Registry) IDs() []string {
+	if r == nil {
+		return nil
+	}
+	ids := make([]string, 0, len(r.connectors))
+	for id := range r.connectors {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+// ID returns the connector's id
+func (c *Connector) ID() string {
+	return c.config.ID
+}
+
+// AuthCodeURL returns the issuer's authorization URL
+func (c *Connector) AuthCodeURL(ctx context.Context, state, nonce string) (string, error) {
+	p, err := c.discover(ctx)
+	if err != nil {
+		return "", err
+	}
+	return p.AuthCodeURL(state, nonce), nil
+}
+
+// Login exchanges code, verifies the ID token against nonce and maps its
+// claims to an Identity
+func (c *Connector) Login(ctx context.Context, code, nonce string) (*Identity, error) {
+	p, err := c.discover(ctx)
+	if err != nil {
+		return nil, err
+	}
+	t, err := p.Login(ctx, code, nonce)
+	if err != nil {
+		return nil, err
+	}
+
+	m := c.config.Claims
+	id := &Identity{
+		Connector:  c.config.ID,
+		Issuer:     t.Issuer,
+		Subject:    t.Subject,
+		Email:      t.String(claim(m.Email, "email")),
+		Name:       t.String(claim(m.Name, "name")),
+		GivenName:  t.String(claim(m.GivenName, "given_name")),
+		FamilyName: t.String(claim(m.FamilyName, "family_name")),
+		Picture:    t.String(claim(m.Picture, "picture")),
+	}
+	id.EmailVerified = id.Email != "" && (c.config.TrustEmail || t.Bool(claim(m.EmailVerified, "email_verified")))
+	return id, nil
+}
+
+// discover returns the issuer's provider, fetching its discovery document
+// once it succeeds
+func (c *Connector) discover(ctx context.Context) (*oidc.Provider, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.provider != nil {
+		return c.provider, nil
+	}
+	p, err := oidc.Discover(ctx, c.config.DiscoveryURL, oidc.Config{
+		ClientID:     c.config.ClientID,
+		ClientSecret: c.config.ClientSecret,
+		RedirectURL:  c.config.RedirectURL,
+		Scopes:       c.config.Scopes,
+	})
+	if err != nil {
+		return nil, err
+	}
+	c.provider = p
+	return p, nil
+}
+
+func claim(mapped, standard string) string {
+	if mapped != "" {
+		return mapped
+	}
+	return standard
+}
@@ -0,0 +1,65 @@
+package connectors
+
+import (
+	"context"
+	"testing"
+
+	"github.com/isaiahwong/accounts-go/internal/oidc/oidctest"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRegistry(t *testing.T) {
+	idp := oidctest.NewServer("client", "secret")
+	defer idp.Close()
+
+	configs, err := Parse([]byte(`[
+		{
+			"id": "corp",
+			"name": "Corp",
+			"discovery_url": "` + idp.URL + `/.well-known/openid-configuration",
+			"client_id": "client",
+			"client_secret": "secret",
+			"redirect_url": "https://example.com/callback",
+			"claims": {"email": "mail", "given_name": "first"},
+			"trust_email": true
+		}
+	]`))
+	assert.NoError(t, err)
+	r, err := NewRegistry(configs)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"corp"}, r.IDs())
+
+	_, err = r.Get("other")
+	assert.Equal(t, ErrNotFound, err)
+
+	t.Run("Maps claims", func(t *testing.T) {
+		c, err := r.Get("corp")
+		assert.NoError(t, err)
+		u, err := c.AuthCodeURL(context.Background(), "state", "nonce")
+		assert.NoError(t, err)
+		code, _, err := idp.Authorize(u, map[string]interface{}{
+			"sub":   "123",
+			"mail":  "isaiah@example.com",
+			"first": "Isaiah",
+		})
+		assert.NoError(t, err)
+
+		id, err := c.Login(context.Background(), code, "nonce")
+		assert.NoError(t, err)
+		assert.Equal(t, idp.URL, id.Issuer)
+		assert.Equal(t, "123", id.Subject)
+		assert.Equal(t, "isaiah@example.com", id.Email)
+		assert.Equal(t, "Isaiah", id.GivenName)
+		assert.True(t, id.EmailVerified)
+	})
+
+	t.Run("Invalid config", func(t *testing.T) {
+		_, err := NewRegistry([]Config{{ID: "a"}})
+		assert.Error(t, err)
+		_, err = NewRegistry([]Config{
+			{ID: "a", DiscoveryURL: "u", ClientID: "c"},
+			{ID: "a", DiscoveryURL: "u", ClientID: "c"},
+		})
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,179 @@
+// Package facebook implements Facebook Login using the OAuth2 authorization
+// code flow and the Graph API.
+package facebook
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// profileFields lists the Graph API user fields requested
+const profileFields = "id,name,first_name,middle_name,last_name,email,picture.type(large)"
+
+// Config defines the Facebook app and the endpoints used
+type Config struct {
+	ClientID     string
+	ClientSecret string
+	RedirectURL  string
+	// AuthURL is the login dialog
+	AuthURL string
+	// GraphURL is the versioned Graph API root
+	GraphURL string
+	Scopes   []string
+}
+
+// Client performs Facebook Login
+type Client struct {
+	config Config
+	client *http.Client
+}
+
+// Profile is the Graph API user
+type Profile struct {
+	ID         string `json:"id"`
+	Name       string `json:"name"`
+	FirstName  string `json:"first_name"`
+	MiddleName string `json:"middle_name"`
+	LastName   string `json:"last_name"`
+	// Email is absent when the user has no confirmed address or declined
+	// the permission
+	Email   string `json:"email"`
+	Picture struct {
+		Data struct {
+			URL          string `json:"url"`
+			IsSilhouette bool   `json:"is_silhouette"`
+		} `json:"data"`
+	} `json:"picture"`
+}
+
+// Error is a Graph API error
+type Error struct {
+	StatusCode int    `json:"-"`
+	Message    string `json:"message"`
+	Type       string `json:"type"`
+	Code       int    `json:"code"`
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("facebook: %v %v (%v): %v", e.StatusCode, e.Type, e.Code, e.Message)
+}
+
+// New returns a Client for config
+func New(config Config) *Client {
+	if config.AuthURL == "" {
+		config.AuthURL = "https://www.facebook.com/v7.0/dialog/oauth"
+	}
+	if config.GraphURL == "" {
+		config.GraphURL = "https://graph.facebook.com/v7.0"
+	}
+	if len(config.Scopes) == 0 {
+		config.Scopes = []string{"email", "public_profile"}
+	}
+	config.GraphURL = strings.TrimRight(config.GraphURL, "/")
+	return &Client{
+		config: config,
+		client: &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+// AuthCodeURL returns the login dialog URL to redirect the user agent to
+func (c *Client) AuthCodeURL(state string) string {
+	v := url.Values{
+		"response_type": {"code"},
+		"client_id":     {c.config.ClientID},
+		"redirect_uri":  {c.config.RedirectURL},
+		"scope":         {strings.Join(c.config.Scopes, ",")},
+		"state":         {state},
+	}
+	return c.config.AuthURL + "?" + v.Encode()
+}
+
+// Exchange redeems an authorization code for a user access token
+func (c *Client) Exchange(ctx context.Context, code string) (string, error) {
+	v := url.Values{
+		"client_id":     {c.config.ClientID},
+		"client_secret": {c.config.ClientSecret},
+		"redirect_uri":  {c.config.RedirectURL},
+		"code":          {code},
+	}
+	var t struct {
+		AccessToken string `json:"access_token"`
+	}
+	if err := c.get(ctx, c.config.GraphURL+"/oauth/access_token?"+v.Encode(), &t); err != nil {
+		return "", err
+	}
+	if t.AccessToken == "" {
+		return "", &Error{Message: "token response has no access_token"}
+	}
+	return t.AccessToken, nil
+}
+
+// Profile fetches the user the access token was issued to
+func (c *Client) Profile(ctx context.Context, accessToken string) (*Profile, error) {
+	// appsecret_proof proves the call comes from the app server
+	m := hmac.New(sha256.New, []byte(c.config.ClientSecret))
+	m.Write([]byte(accessToken))
+	v := url.Values{
+		"fields":          {profileFields},
+		"access_token":    {accessToken},
+		"appsecret_proof": {hex.EncodeToString(m.Sum(nil))},
+	}
+	p := &Profile{}
+	if err := c.get(ctx, c.config.GraphURL+"/me?"+v.Encode(), p); err != nil {
+		return nil, err
+	}
+	if p.ID == "" {
+		return nil, &Error{Message: "profile has no id"}
+	}
+	return p, nil
+}
+
+// Login exchanges code and fetches the user's profile
+func (c *Client) Login(ctx context.Context, code string) (*Profile, error) {
+	token, err := c.Exchange(ctx, code)
+	if err != nil {
+		return nil, err
+	}
+	return c.Profile(ctx, token)
+}
+
+func (c *Client) get(ctx context.Context, u string, v interface{}) error {
+	req, err := http.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return err
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	body, err := ioutil.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		var e struct {
+			Error *Error `json:"error"`
+		}
+		json.Unmarshal(body, &e)
+		if e.Error == nil {
+			e.Error = &Error{}
+		}
+		e.Error.StatusCode = resp.StatusCode
+		return e.Error
+	}
+	return json.Unmarshal(body, v)
+}
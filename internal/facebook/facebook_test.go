@@ -0,0 +1,67 @@
+package facebook
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLogin(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/oauth/access_token", func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		if q.Get("code") != "code" || q.Get("client_secret") != "secret" || q.Get("redirect_uri") != "https://example.com/callback" {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"error":{"message":"Invalid verification code format.","type":"OAuthException","code":100}}`))
+			return
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{"access_token": "token", "token_type": "bearer"})
+	})
+	mux.HandleFunc("/me", func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		m := hmac.New(sha256.New, []byte("secret"))
+		m.Write([]byte("token"))
+		assert.Equal(t, hex.EncodeToString(m.Sum(nil)), q.Get("appsecret_proof"))
+		w.Write([]byte(`{"id":"fb-123","name":"Isaiah Wong","first_name":"Isaiah","last_name":"Wong","email":"isaiah@example.com","picture":{"data":{"url":"https://example.com/isaiah.png"}}}`))
+	})
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	c := New(Config{
+		ClientID:     "client",
+		ClientSecret: "secret",
+		RedirectURL:  "https://example.com/callback",
+		GraphURL:     srv.URL + "/",
+	})
+
+	t.Run("AuthCodeURL", func(t *testing.T) {
+		u, err := url.Parse(c.AuthCodeURL("state"))
+		assert.NoError(t, err)
+		assert.Equal(t, "state", u.Query().Get("state"))
+		assert.Equal(t, "email,public_profile", u.Query().Get("scope"))
+	})
+
+	t.Run("Valid code", func(t *testing.T) {
+		p, err := c.Login(context.Background(), "code")
+		assert.NoError(t, err)
+		assert.Equal(t, "fb-123", p.ID)
+		assert.Equal(t, "isaiah@example.com", p.Email)
+		assert.Equal(t, "https://example.com/isaiah.png", p.Picture.Data.URL)
+	})
+
+	t.Run("Invalid code", func(t *testing.T) {
+		_, err := c.Login(context.Background(), "wrong")
+		if assert.IsType(t, &Error{}, err) {
+			assert.Equal(t, 100, err.(*Error).Code)
+			assert.Equal(t, http.StatusBadRequest, err.(*Error).StatusCode)
+		}
+	})
+}
@@ -8,39 +8,185 @@ import (
 
 // Auth type
 type Auth struct {
-	Email                    string    `bson:"email" json:"email"`
-	FirstName                string    `bson:"first_name" json:"first_name"`
-	LastName                 string    `bson:"last_name" json:"last_name"`
-	Name                     string    `bson:"name" json:"name"`
-	Picture                  string    `bson:"picture" json:"picture"`
-	Password                 string    `bson:"password" json:"password"`
-	PasswordHashMethod       string    `bson:"password_hash_method" json:"password_hash_method"`
-	PasswordResetID          string    `bson:"password_reset_id" json:"password_reset_id"`
-	PasswordResetToken       string    `bson:"password_reset_token" json:"password_reset_token"`
-	PasswordResetExpires     time.Time `bson:"password_reset_expires" json:"password_reset_expires"`
-	PasswordModified         time.Time `bson:"password_modified" json:"password_modified"`
-	Verified                 bool      `bson:"verified" json:"verified"`
-	VerifiedDate             time.Time `bson:"verified_date" json:"verified_date"`
-	VerificationToken        string    `bson:"verification_token" json:"verification_token"`
-	VerificationTokenExpires time.Time `bson:"verification_token_expires" json:"verification_token_expires"`
+	Facebook                 *UserProfile       `bson:"facebook,omitempty" json:"facebook,omitempty"`
+	Google                   *UserProfile       `bson:"google,omitempty" json:"google,omitempty"`
+	Email                    string             `bson:"email" json:"email"`
+	FirstName                string             `bson:"first_name" json:"first_name"`
+	LastName                 string             `bson:"last_name" json:"last_name"`
+	Name                     string             `bson:"name" json:"name"`
+	Picture                  string             `bson:"picture" json:"picture"`
+	Password                 string             `bson:"password" json:"password"`
+	PasswordHashMethod       string             `bson:"password_hash_method" json:"password_hash_method"`
+	PasswordResetID          string             `bson:"password_reset_id" json:"password_reset_id"`
+	PasswordResetToken       string             `bson:"password_reset_token" json:"password_reset_token"`
+	PasswordResetExpires     time.Time          `bson:"password_reset_expires" json:"password_reset_expires"`
+	PasswordModified         time.Time          `bson:"password_modified" json:"password_modified"`
+	PasswordHistory          []PreviousPassword `bson:"password_history" json:"password_history"`
+	Verified                 bool               `bson:"verified" json:"verified"`
+	VerifiedDate             time.Time          `bson:"verified_date" json:"verified_date"`
+	VerificationToken        string             `bson:"verification_token" json:"verification_token"`
+	VerificationTokenExpires time.Time          `bson:"verification_token_expires" json:"verification_token_expires"`
+	VerificationSent         time.Time          `bson:"verification_sent" json:"verification_sent"`
+}
+
+// PreviousPassword is the hash of a password the account used before, kept
+// to prevent its reuse
+type PreviousPassword struct {
+	Hash     string    `bson:"hash" json:"hash"`
+	Method   string    `bson:"method" json:"method"`
+	Replaced time.Time `bson:"replaced" json:"replaced"`
 }
 
 type Session struct {
 	IP        string    `bson:"ip" json:"ip"`
+	SID       string    `bson:"sid" json:"sid"`
 	Device    string    `bson:"device" json:"device"`
 	Timestamp time.Time `bson:"timestamp" json:"timestamp"`
 	Location  string    `bson:"location" json:"location"`
 	Lat       int32     `bson:"lat" json:"lat"`
 	Long      int32     `bson:"long" json:"long"`
+	LoggedOut time.Time `bson:"logged_out" json:"logged_out"`
+}
+
+// RecoveryCode is a single use code which completes the second factor
+// without the enrolled device. Only its digest is stored.
+type RecoveryCode struct {
+	Hash   string    `bson:"hash" json:"hash"`
+	Used   bool      `bson:"used" json:"used"`
+	UsedAt time.Time `bson:"used_at" json:"used_at"`
+	UsedIP string    `bson:"used_ip" json:"used_ip"`
+}
+
+// MFA type
+type MFA struct {
+	Enabled             bool           `bson:"enabled" json:"enabled"`
+	EnabledDate         time.Time      `bson:"enabled_date" json:"enabled_date"`
+	TOTPSecret          string         `bson:"totp_secret" json:"totp_secret"`
+	TOTPPending         string         `bson:"totp_pending" json:"totp_pending"`
+	TOTPStep            int64          `bson:"totp_step" json:"totp_step"`
+	RecoveryCodes       []RecoveryCode `bson:"recovery_codes" json:"recovery_codes"`
+	RecoveryCodesIssued time.Time      `bson:"recovery_codes_issued" json:"recovery_codes_issued"`
+}
+
+// WebAuthnCredential is a public key credential registered to the account
+type WebAuthnCredential struct {
+	ID        []byte    `bson:"id" json:"id"`
+	PublicKey []byte    `bson:"public_key" json:"public_key"`
+	SignCount int64     `bson:"sign_count" json:"sign_count"`
+	AAGUID    []byte    `bson:"aaguid" json:"aaguid"`
+	CreatedAt time.Time `bson:"created_at" json:"created_at"`
+	LastUsed  time.Time `bson:"last_used" json:"last_used"`
+}
+
+// WebAuthnAssertion is a passkey sign in in progress. Challenge is the
+// challenge the authenticator signs.
+type WebAuthnAssertion struct {
+	Challenge string    `bson:"challenge" json:"challenge"`
+	Expires   time.Time `bson:"expires" json:"expires"`
+}
+
+// WebAuthn holds the account's credentials and the ceremonies in progress.
+// Challenge and Expires belong to a registration. Assertions are the sign
+// ins in progress keyed by the digest of their login challenge, so one sign
+// in cannot cancel a registration or another sign in. Assertions is omitted
+// rather than stored as null, which fields cannot be set within.
+type WebAuthn struct {
+	Credentials []WebAuthnCredential         `bson:"credentials" json:"credentials"`
+	Challenge   string                       `bson:"challenge" json:"challenge"`
+	Expires     time.Time                    `bson:"expires" json:"expires"`
+	Assertions  map[string]WebAuthnAssertion `bson:"assertions,omitempty" json:"assertions,omitempty"`
+}
+
+// MagicLink is a single use sign in token mailed to the account. Only the
+// digests of the token and the login challenge it was requested for are
+// stored.
+type MagicLink struct {
+	Token          string    `bson:"token" json:"token"`
+	LoginChallenge string    `bson:"login_challenge" json:"login_challenge"`
+	Expires        time.Time `bson:"expires" json:"expires"`
+	IP             string    `bson:"ip" json:"ip"`
+}
+
+// LoginCode is a numeric one time code mailed to the account for a login
+// challenge. Only digests are stored. LockedUntil is kept across codes so
+// exhausting the attempts cannot be bypassed by requesting another.
+type LoginCode struct {
+	Code           string    `bson:"code" json:"code"`
+	LoginChallenge string    `bson:"login_challenge" json:"login_challenge"`
+	Expires        time.Time `bson:"expires" json:"expires"`
+	Attempts       int       `bson:"attempts" json:"attempts"`
+	LockedUntil    time.Time `bson:"locked_until" json:"locked_until"`
+}
+
+// Phone is the account's phone number. Pending holds a number awaiting
+// verification. Code is the digest of the last SMS code sent, whether for
+// verifying Pending or as a second factor. MFA enables the number as a
+// second factor.
+type Phone struct {
+	Number       string    `bson:"number" json:"number"`
+	Verified     bool      `bson:"verified" json:"verified"`
+	VerifiedDate time.Time `bson:"verified_date" json:"verified_date"`
+	MFA          bool      `bson:"mfa" json:"mfa"`
+	Pending      string    `bson:"pending" json:"pending"`
+	Code         string    `bson:"code" json:"code"`
+	CodeExpires  time.Time `bson:"code_expires" json:"code_expires"`
+	CodeSent     time.Time `bson:"code_sent" json:"code_sent"`
+	Attempts     int       `bson:"attempts" json:"attempts"`
+}
+
+// EmailChange holds an address awaiting verification before it replaces
+// the account's email, and once replaced, the previous address with a token
+// mailed to it which restores it. Only token digests are stored.
+type EmailChange struct {
+	Pending       string    `bson:"pending" json:"pending"`
+	Token         string    `bson:"token" json:"token"`
+	Expires       time.Time `bson:"expires" json:"expires"`
+	RevertEmail   string    `bson:"revert_email" json:"revert_email"`
+	RevertToken   string    `bson:"revert_token" json:"revert_token"`
+	RevertExpires time.Time `bson:"revert_expires" json:"revert_expires"`
+}
+
+// Lockout tracks failed password attempts. Locks counts the locks applied
+// since the last successful login so each can last longer than the last.
+type Lockout struct {
+	FailedAttempts int       `bson:"failed_attempts" json:"failed_attempts"`
+	LastFailed     time.Time `bson:"last_failed" json:"last_failed"`
+	LockedUntil    time.Time `bson:"locked_until" json:"locked_until"`
+	Locks          int       `bson:"locks" json:"locks"`
+}
+
+// PendingLogin binds a login challenge to an account which requires
+// further steps before the login can be accepted
+type PendingLogin struct {
+	Challenge string    `bson:"challenge" json:"challenge"`
+	Expires   time.Time `bson:"expires" json:"expires"`
+	Attempts  int       `bson:"attempts" json:"attempts"`
+	// AMR lists the authentication methods completed so far
+	AMR []string `bson:"amr" json:"amr"`
+	// PasswordChange is set when the password has expired and must be
+	// changed before the login completes
+	PasswordChange bool `bson:"password_change" json:"password_change"`
 }
 
 // Account type
 type Account struct {
-	ID        primitive.ObjectID `bson:"_id,omitempty" json:"id"`
-	Object    string             `bson:"object" json:"object" validate:" eq=accounts,required" `
-	Auth      Auth               `bson:"auth" json:"auth"`
-	CreatedAt time.Time          `bson:"created_at" json:"created_at"`
-	UpdatedAt time.Time          `bson:"updated_at" json:"updated_at"`
-	LoggedIn  time.Time          `bson:"logged_in" json:"logged_in"`
-	Sessions  []Session          `bson:"sessions" json:"sessions"`
+	ID          primitive.ObjectID `bson:"_id,omitempty" json:"id"`
+	Object      string             `bson:"object" json:"object" validate:" eq=accounts,required" `
+	Auth        Auth               `bson:"auth" json:"auth"`
+	MFA         MFA                `bson:"mfa" json:"mfa"`
+	WebAuthn    WebAuthn           `bson:"webauthn" json:"webauthn"`
+	Identities  []Identity         `bson:"identities" json:"identities"`
+	MagicLink   MagicLink          `bson:"magic_link" json:"magic_link"`
+	LoginCode   LoginCode          `bson:"login_code" json:"login_code"`
+	Phone       Phone              `bson:"phone" json:"phone"`
+	Lockout     Lockout            `bson:"lockout" json:"lockout"`
+	EmailChange EmailChange        `bson:"email_change" json:"email_change"`
+	Preferences Preferences        `bson:"preferences" json:"preferences"`
+	CreatedAt   time.Time          `bson:"created_at" json:"created_at"`
+	UpdatedAt   time.Time          `bson:"updated_at" json:"updated_at"`
+	LoggedIn    time.Time          `bson:"logged_in" json:"logged_in"`
+	LoggedOut   time.Time          `bson:"logged_out" json:"logged_out"`
+	Sessions    []Session          `bson:"sessions" json:"sessions"`
+
+	PendingLogin PendingLogin `bson:"pending_login" json:"pending_login"`
 }
@@ -1,5 +1,7 @@
 package models
 
+import "time"
+
 // EmailNotifications type
 type EmailNotifications struct {
 	UnsubscribeFromAll bool `bson:"unsubscribe_from_all" json:"unsubscribe_from_all"`
@@ -23,3 +25,37 @@ type ProfileName struct {
 	MiddleName string `bson:"middle_name" json:"middle_name"`
 	GivenName  string `bson:"given_name" json:"given_name"`
 }
+
+// ProfileEmail type
+type ProfileEmail struct {
+	Value string `bson:"value" json:"value"`
+	Type  string `bson:"type" json:"type"`
+}
+
+// ProfilePhoto type
+type ProfilePhoto struct {
+	Value string `bson:"value" json:"value"`
+}
+
+// UserProfile is the profile of an upstream identity provider linked to
+// the account
+type UserProfile struct {
+	Provider    string         `bson:"provider" json:"provider"`
+	ID          string         `bson:"id" json:"id"`
+	DisplayName string         `bson:"display_name" json:"display_name"`
+	Name        ProfileName    `bson:"name" json:"name"`
+	Emails      []ProfileEmail `bson:"emails" json:"emails"`
+	Photos      []ProfilePhoto `bson:"photos" json:"photos"`
+	Linked      time.Time      `bson:"linked" json:"linked"`
+}
+
+// Identity links the account to a subject at an upstream OpenID Connect
+// issuer. Issuer and Subject together identify the upstream account.
+type Identity struct {
+	Issuer    string    `bson:"issuer" json:"issuer"`
+	Subject   string    `bson:"subject" json:"subject"`
+	Connector string    `bson:"connector" json:"connector"`
+	Email     string    `bson:"email" json:"email"`
+	Linked    time.Time `bson:"linked" json:"linked"`
+	LastLogin time.Time `bson:"last_login" json:"last_login"`
+}
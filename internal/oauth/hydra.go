@@ -83,6 +83,10 @@ func (h *Hydra) put(flow string, action string, challenge string, body interface
 	}
 
 	r := &HydraRedirect{}
+	// Hydra responds with 204 No Content for rejected logouts
+	if len(b) == 0 {
+		return r, nil
+	}
 	err = json.Unmarshal(b, r)
 	if err != nil {
 		return nil, err
@@ -124,7 +128,7 @@ func (h *Hydra) AcceptLogout(challenge string) (*HydraRedirect, error) {
 }
 
 func (h *Hydra) RejectLogout(challenge string, body *HydraError) (*HydraRedirect, error) {
-	return h.put("logout", "reject", challenge, nil)
+	return h.put("logout", "reject", challenge, body)
 }
 
 func (h *Hydra) Introspect(token, scope string) (*InstrospectResponse, error) {
@@ -166,6 +170,56 @@ func (h *Hydra) Introspect(token, scope string) (*InstrospectResponse, error) {
 	return r, nil
 }
 
+// session requests the admin sessions endpoint of kind "login" or
+// "consent". The response body is decoded into out when given.
+func (h *Hydra) session(method, kind string, query url.Values, out interface{}) error {
+	target := fmt.Sprintf("%v/oauth2/auth/sessions/%v?%v", h.hydraURL, kind, query.Encode())
+	req, err := http.NewRequest(method, target, nil)
+	if err != nil {
+		return err
+	}
+	client := &http.Client{}
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	b, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 302 {
+		he := &HydraError{}
+		if err := json.Unmarshal(b, he); err != nil {
+			return errors.New("An error while making request to hydra " + string(b))
+		}
+		return he
+	}
+	if out == nil || len(b) == 0 {
+		return nil
+	}
+	return json.Unmarshal(b, out)
+}
+
+// RevokeLoginSessions invalidates the subject's remembered logins so every
+// browser must authenticate again
+func (h *Hydra) RevokeLoginSessions(subject string) error {
+	return h.session("DELETE", "login", url.Values{"subject": {subject}}, nil)
+}
+
+// ConsentSessions lists the consents the subject has granted
+func (h *Hydra) ConsentSessions(subject string) ([]HydraConsentSession, error) {
+	var sessions []HydraConsentSession
+	err := h.session("GET", "consent", url.Values{"subject": {subject}}, &sessions)
+	return sessions, err
+}
+
+// RevokeConsentSessions revokes the consents the subject granted client and
+// the tokens issued under them
+func (h *Hydra) RevokeConsentSessions(subject, client string) error {
+	return h.session("DELETE", "consent", url.Values{"subject": {subject}, "client": {client}}, nil)
+}
+
 // NewHydraClient
 func NewHydraClient() *Hydra {
 	url := common.MapEnvWithDefaults("HYDRA_ADMIN_URL", "http://localhost:9000")
@@ -89,8 +89,24 @@ type HydraResponse struct {
 	Subject                      string   `json:"subject"`
 }
 
+// Authentication Context Class Reference values
+const (
+	ACRSingleFactor = "aal1"
+	ACRMultiFactor  = "aal2"
+)
+
+// Authentication Method Reference values as defined in RFC 8176
+const (
+	AMRPassword    = "pwd"
+	AMROTP         = "otp"
+	AMRMFA         = "mfa"
+	AMRHardwareKey = "hwk"
+	AMRSMS         = "sms"
+)
+
 type HydraLoginAccept struct {
-	Acr     string `json:"acr"`
+	Acr     string   `json:"acr"`
+	Amr     []string `json:"amr,omitempty"`
 	Context struct {
 		Property1 []byte `json:"property1"`
 		Property2 []byte `json:"property2"`
@@ -121,8 +137,8 @@ type AccessToken struct {
 }
 
 type Session struct {
-	AccessToken AccessToken       `json:"access_token"`
-	IDToken     map[string]string `json:"id_token"`
+	AccessToken AccessToken            `json:"access_token"`
+	IDToken     map[string]interface{} `json:"id_token"`
 }
 
 type HydraConsentAccept struct {
@@ -138,6 +154,17 @@ type HydraRedirect struct {
 	RedirectTo string `json:"redirect_to"`
 }
 
+// HydraConsentSession is a consent a subject previously granted a client
+type HydraConsentSession struct {
+	ConsentRequest struct {
+		Client struct {
+			ClientID string `json:"client_id"`
+		} `json:"client"`
+		Subject string `json:"subject"`
+	} `json:"consent_request"`
+	GrantScope []string `json:"grant_scope"`
+}
+
 type Ext struct {
 	Property1 struct {
 	} `json:"property1"`
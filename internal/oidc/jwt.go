@@ -0,0 +1,288 @@
+package oidc
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"strings"
+	"time"
+)
+
+// clockSkew is the leeway allowed when checking token times
+const clockSkew = time.Minute
+
+// jwksRefreshInterval bounds how often keys are refetched for unknown key IDs
+const jwksRefreshInterval = time.Minute
+
+// Errors returned when verifying an ID token
+var (
+	ErrMalformedToken = errors.New("oidc: malformed id token")
+	ErrSignature      = errors.New("oidc: invalid id token signature")
+	ErrUnknownKey     = errors.New("oidc: unknown signing key")
+	ErrIssuer         = errors.New("oidc: issuer mismatch")
+	ErrAudience       = errors.New("oidc: audience mismatch")
+	ErrExpired        = errors.New("oidc: id token expired")
+	ErrNonce          = errors.New("oidc: nonce mismatch")
+)
+
+// IDToken is a verified ID token
+type IDToken struct {
+	Issuer   string
+	Subject  string
+	Audience []string
+	Expiry   time.Time
+	IssuedAt time.Time
+	Nonce    string
+	// Claims holds every claim in the token
+	Claims map[string]interface{}
+}
+
+// String returns a string claim or an empty string
+func (t *IDToken) String(claim string) string {
+	s, _ := t.Claims[claim].(string)
+	return s
+}
+
+// Bool returns a boolean claim. Some providers encode booleans as strings.
+func (t *IDToken) Bool(claim string) bool {
+	switch v := t.Claims[claim].(type) {
+	case bool:
+		return v
+	case string:
+		return v == "true"
+	}
+	return false
+}
+
+type jwtHeader struct {
+	Alg string `json:"alg"`
+	Kid string `json:"kid"`
+}
+
+type jwk struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+// Verify checks the ID token's signature against the provider's JWKS and
+// validates its issuer, audience, expiry and nonce
+func (p *Provider) Verify(ctx context.Context, raw, nonce string) (*IDToken, error) {
+	parts := strings.Split(raw, ".")
+	if len(parts) != 3 {
+		return nil, ErrMalformedToken
+	}
+	hb, err := base64.RawURLEncoding.DecodeString(parts[0])
+	if err != nil {
+		return nil, ErrMalformedToken
+	}
+	var h jwtHeader
+	if err := json.Unmarshal(hb, &h); err != nil {
+		return nil, ErrMalformedToken
+	}
+	sig, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, ErrMalformedToken
+	}
+
+	key, err := p.key(ctx, h.Kid)
+	if err != nil {
+		return nil, err
+	}
+	if err := verifySignature(h.Alg, key, []byte(parts[0]+"."+parts[1]), sig); err != nil {
+		return nil, err
+	}
+
+	pb, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return nil, ErrMalformedToken
+	}
+	t := &IDToken{}
+	dec := json.NewDecoder(strings.NewReader(string(pb)))
+	dec.UseNumber()
+	if err := dec.Decode(&t.Claims); err != nil {
+		return nil, ErrMalformedToken
+	}
+	t.Issuer = t.String("iss")
+	t.Subject = t.String("sub")
+	t.Nonce = t.String("nonce")
+	t.Expiry = claimTime(t.Claims["exp"])
+	t.IssuedAt = claimTime(t.Claims["iat"])
+	switch aud := t.Claims["aud"].(type) {
+	case string:
+		t.Audience = []string{aud}
+	case []interface{}:
+		for _, a := range aud {
+			if s, ok := a.(string); ok {
+				t.Audience = append(t.Audience, s)
+			}
+		}
+	}
+
+	if t.Issuer != p.config.Issuer {
+		return nil, ErrIssuer
+	}
+	if !contains(t.Audience, p.config.ClientID) {
+		return nil, ErrAudience
+	}
+	if t.Subject == "" {
+		return nil, ErrMalformedToken
+	}
+	if t.Expiry.IsZero() || time.Now().After(t.Expiry.Add(clockSkew)) {
+		return nil, ErrExpired
+	}
+	if subtle.ConstantTimeCompare([]byte(t.Nonce), []byte(nonce)) != 1 {
+		return nil, ErrNonce
+	}
+	return t, nil
+}
+
+// key returns the signing key for kid, refreshing the JWKS when the key is
+// unknown
+func (p *Provider) key(ctx context.Context, kid string) (interface{}, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if k, ok := p.lookup(kid); ok {
+		return k, nil
+	}
+	if time.Since(p.fetched) < jwksRefreshInterval {
+		return nil, ErrUnknownKey
+	}
+
+	var set struct {
+		Keys []jwk `json:"keys"`
+	}
+	if err := p.getJSON(ctx, p.config.JWKSURL, &set); err != nil {
+		return nil, err
+	}
+	keys := map[string]interface{}{}
+	for _, k := range set.Keys {
+		if k.Use != "" && k.Use != "sig" {
+			continue
+		}
+		if pub, err := k.publicKey(); err == nil {
+			keys[k.Kid] = pub
+		}
+	}
+	p.keys = keys
+	p.fetched = time.Now()
+
+	if k, ok := p.lookup(kid); ok {
+		return k, nil
+	}
+	return nil, ErrUnknownKey
+}
+
+// lookup finds kid in the cached keys. Tokens without a kid are accepted
+// when the set holds a single key.
+func (p *Provider) lookup(kid string) (interface{}, bool) {
+	if k, ok := p.keys[kid]; ok {
+		return k, true
+	}
+	if kid == "" && len(p.keys) == 1 {
+		for _, k := range p.keys {
+			return k, true
+		}
+	}
+	return nil, false
+}
+
+func (k jwk) publicKey() (interface{}, error) {
+	switch k.Kty {
+	case "RSA":
+		n, err := base64.RawURLEncoding.DecodeString(k.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := base64.RawURLEncoding.DecodeString(k.E)
+		if err != nil || len(e) == 0 || len(e) > 4 {
+			return nil, ErrMalformedToken
+		}
+		return &rsa.PublicKey{
+			N: new(big.Int).SetBytes(n),
+			E: int(new(big.Int).SetBytes(e).Int64()),
+		}, nil
+	case "EC":
+		if k.Crv != "P-256" {
+			return nil, ErrUnknownKey
+		}
+		x, err := base64.RawURLEncoding.DecodeString(k.X)
+		if err != nil {
+			return nil, err
+		}
+		y, err := base64.RawURLEncoding.DecodeString(k.Y)
+		if err != nil {
+			return nil, err
+		}
+		pub := &ecdsa.PublicKey{
+			Curve: elliptic.P256(),
+			X:     new(big.Int).SetBytes(x),
+			Y:     new(big.Int).SetBytes(y),
+		}
+		if !pub.Curve.IsOnCurve(pub.X, pub.Y) {
+			return nil, ErrMalformedToken
+		}
+		return pub, nil
+	}
+	return nil, ErrUnknownKey
+}
+
+func verifySignature(alg string, key interface{}, signed, sig []byte) error {
+	digest := sha256.Sum256(signed)
+	switch alg {
+	case "RS256":
+		pub, ok := key.(*rsa.PublicKey)
+		if !ok || rsa.VerifyPKCS1v15(pub, crypto.SHA256, digest[:], sig) != nil {
+			return ErrSignature
+		}
+		return nil
+	case "ES256":
+		pub, ok := key.(*ecdsa.PublicKey)
+		if !ok || len(sig) != 64 {
+			return ErrSignature
+		}
+		r := new(big.Int).SetBytes(sig[:32])
+		s := new(big.Int).SetBytes(sig[32:])
+		if !ecdsa.Verify(pub, digest[:], r, s) {
+			return ErrSignature
+		}
+		return nil
+	}
+	// Rejects "none" and symmetric algorithms
+	return ErrSignature
+}
+
+func claimTime(v interface{}) time.Time {
+	n, ok := v.(json.Number)
+	if !ok {
+		return time.Time{}
+	}
+	f, err := n.Float64()
+	if err != nil {
+		return time.Time{}
+	}
+	return time.Unix(int64(f), 0)
+}
+
+func contains(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
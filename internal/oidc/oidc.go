@@ -0,0 +1,182 @@
+// Package oidc implements the relying party side of the OpenID Connect
+// authorization code flow for signing in with upstream identity providers.
+package oidc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Config defines an upstream provider and the client registered with it
+type Config struct {
+	Issuer       string
+	ClientID     string
+	ClientSecret string
+	RedirectURL  string
+	AuthURL      string
+	TokenURL     string
+	JWKSURL      string
+	Scopes       []string
+}
+
+// Provider performs the authorization code flow against an upstream
+// provider
+type Provider struct {
+	config Config
+	client *http.Client
+
+	mu      sync.Mutex
+	keys    map[string]interface{}
+	fetched time.Time
+}
+
+// Token is the token endpoint response
+type Token struct {
+	AccessToken string `json:"access_token"`
+	TokenType   string `json:"token_type"`
+	IDToken     string `json:"id_token"`
+	ExpiresIn   int64  `json:"expires_in"`
+}
+
+// Error is an error response from the provider
+type Error struct {
+	StatusCode  int    `json:"-"`
+	Code        string `json:"error"`
+	Description string `json:"error_description"`
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("oidc: %v %v: %v", e.StatusCode, e.Code, e.Description)
+}
+
+// New returns a Provider for config
+func New(config Config) *Provider {
+	if len(config.Scopes) == 0 {
+		config.Scopes = []string{"openid", "email", "profile"}
+	}
+	return &Provider{
+		config: config,
+		client: &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+// Discover reads the provider's configuration from its discovery document
+// https://openid.net/specs/openid-connect-discovery-1_0.html
+func Discover(ctx context.Context, discoveryURL string, config Config) (*Provider, error) {
+	p := New(config)
+	var doc struct {
+		Issuer                string `json:"issuer"`
+		AuthorizationEndpoint string `json:"authorization_endpoint"`
+		TokenEndpoint         string `json:"token_endpoint"`
+		JWKSURI               string `json:"jwks_uri"`
+	}
+	if err := p.getJSON(ctx, discoveryURL, &doc); err != nil {
+		return nil, err
+	}
+	if doc.Issuer == "" || doc.AuthorizationEndpoint == "" || doc.TokenEndpoint == "" || doc.JWKSURI == "" {
+		return nil, errors.New("oidc: incomplete discovery document")
+	}
+	p.config.Issuer = doc.Issuer
+	p.config.AuthURL = doc.AuthorizationEndpoint
+	p.config.TokenURL = doc.TokenEndpoint
+	p.config.JWKSURL = doc.JWKSURI
+	return p, nil
+}
+
+// Issuer returns the provider's issuer identifier
+func (p *Provider) Issuer() string {
+	return p.config.Issuer
+}
+
+// AuthCodeURL returns the URL to redirect the user agent to
+func (p *Provider) AuthCodeURL(state, nonce string) string {
+	v := url.Values{
+		"response_type": {"code"},
+		"client_id":     {p.config.ClientID},
+		"redirect_uri":  {p.config.RedirectURL},
+		"scope":         {strings.Join(p.config.Scopes, " ")},
+		"state":         {state},
+		"nonce":         {nonce},
+	}
+	sep := "?"
+	if strings.Contains(p.config.AuthURL, "?") {
+		sep = "&"
+	}
+	return p.config.AuthURL + sep + v.Encode()
+}
+
+// Exchange redeems an authorization code at the token endpoint
+func (p *Provider) Exchange(ctx context.Context, code string) (*Token, error) {
+	form := url.Values{
+		"grant_type":    {"authorization_code"},
+		"code":          {code},
+		"redirect_uri":  {p.config.RedirectURL},
+		"client_id":     {p.config.ClientID},
+		"client_secret": {p.config.ClientSecret},
+	}
+	req, err := http.NewRequest(http.MethodPost, p.config.TokenURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+	t := &Token{}
+	if err := p.do(ctx, req, t); err != nil {
+		return nil, err
+	}
+	if t.AccessToken == "" {
+		return nil, errors.New("oidc: token response has no access_token")
+	}
+	return t, nil
+}
+
+// Login exchanges code and verifies the returned ID token against nonce
+func (p *Provider) Login(ctx context.Context, code, nonce string) (*IDToken, error) {
+	t, err := p.Exchange(ctx, code)
+	if err != nil {
+		return nil, err
+	}
+	if t.IDToken == "" {
+		return nil, errors.New("oidc: token response has no id_token")
+	}
+	return p.Verify(ctx, t.IDToken, nonce)
+}
+
+func (p *Provider) getJSON(ctx context.Context, u string, v interface{}) error {
+	req, err := http.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+	return p.do(ctx, req, v)
+}
+
+func (p *Provider) do(ctx context.Context, req *http.Request, v interface{}) error {
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	body, err := ioutil.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		e := &Error{StatusCode: resp.StatusCode}
+		json.Unmarshal(body, e)
+		return e
+	}
+	return json.Unmarshal(body, v)
+}
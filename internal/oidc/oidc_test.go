@@ -0,0 +1,91 @@
+package oidc_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/isaiahwong/accounts-go/internal/oidc"
+	"github.com/isaiahwong/accounts-go/internal/oidc/oidctest"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLogin(t *testing.T) {
+	idp := oidctest.NewServer("client", "secret")
+	defer idp.Close()
+	p := oidc.New(idp.Config("https://example.com/callback"))
+	ctx := context.Background()
+
+	t.Run("Valid", func(t *testing.T) {
+		code, state, err := idp.Authorize(p.AuthCodeURL("state", "nonce"), map[string]interface{}{
+			"sub":            "123",
+			"email":          "isaiah@example.com",
+			"email_verified": true,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, "state", state)
+
+		tok, err := p.Login(ctx, code, "nonce")
+		assert.NoError(t, err)
+		assert.Equal(t, "123", tok.Subject)
+		assert.Equal(t, "isaiah@example.com", tok.String("email"))
+		assert.True(t, tok.Bool("email_verified"))
+
+		// Codes are single use
+		_, err = p.Login(ctx, code, "nonce")
+		assert.IsType(t, &oidc.Error{}, err)
+	})
+
+	t.Run("Wrong nonce", func(t *testing.T) {
+		code, _, _ := idp.Authorize(p.AuthCodeURL("state", "nonce"), map[string]interface{}{"sub": "123"})
+		_, err := p.Login(ctx, code, "other")
+		assert.Equal(t, oidc.ErrNonce, err)
+	})
+
+	claims := func(override map[string]interface{}) map[string]interface{} {
+		c := map[string]interface{}{
+			"iss":   idp.URL,
+			"aud":   "client",
+			"sub":   "123",
+			"nonce": "nonce",
+			"exp":   time.Now().Add(time.Hour).Unix(),
+		}
+		for k, v := range override {
+			c[k] = v
+		}
+		return c
+	}
+
+	t.Run("Wrong audience", func(t *testing.T) {
+		_, err := p.Verify(ctx, idp.Sign(claims(map[string]interface{}{"aud": "other"})), "nonce")
+		assert.Equal(t, oidc.ErrAudience, err)
+	})
+
+	t.Run("Wrong issuer", func(t *testing.T) {
+		_, err := p.Verify(ctx, idp.Sign(claims(map[string]interface{}{"iss": "https://evil.com"})), "nonce")
+		assert.Equal(t, oidc.ErrIssuer, err)
+	})
+
+	t.Run("Expired", func(t *testing.T) {
+		_, err := p.Verify(ctx, idp.Sign(claims(map[string]interface{}{"exp": time.Now().Add(-time.Hour).Unix()})), "nonce")
+		assert.Equal(t, oidc.ErrExpired, err)
+	})
+
+	t.Run("Tampered", func(t *testing.T) {
+		token := idp.Sign(claims(nil))
+		other := idp.Sign(claims(map[string]interface{}{"sub": "456"}))
+		// Swap the payload keeping the original signature
+		_, err := p.Verify(ctx, token[:strings.Index(token, ".")]+other[strings.Index(other, "."):strings.LastIndex(other, ".")]+token[strings.LastIndex(token, "."):], "nonce")
+		assert.Equal(t, oidc.ErrSignature, err)
+	})
+}
+
+func TestDiscover(t *testing.T) {
+	idp := oidctest.NewServer("client", "secret")
+	defer idp.Close()
+
+	p, err := oidc.Discover(context.Background(), idp.URL+"/.well-known/openid-configuration", oidc.Config{ClientID: "client"})
+	assert.NoError(t, err)
+	assert.Equal(t, idp.URL, p.Issuer())
+}
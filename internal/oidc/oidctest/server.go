@@ -0,0 +1,175 @@
+// Package oidctest provides a fake OpenID Connect provider for testing
+// federated login without network access.
+package oidctest
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/isaiahwong/accounts-go/internal/oidc"
+)
+
+// Server is a fake provider issuing RS256 signed ID tokens
+type Server struct {
+	*httptest.Server
+	ClientID     string
+	ClientSecret string
+	// KeyID is advertised in the JWKS and token headers
+	KeyID string
+
+	key   *rsa.PrivateKey
+	mu    sync.Mutex
+	codes map[string]grant
+	mux   *http.ServeMux
+}
+
+type grant struct {
+	redirectURI string
+	claims      map[string]interface{}
+}
+
+// NewServer starts a provider with a registered client
+func NewServer(clientID, clientSecret string) *Server {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		panic(err)
+	}
+	s := &Server{
+		ClientID:     clientID,
+		ClientSecret: clientSecret,
+		KeyID:        "test",
+		key:          key,
+		codes:        map[string]grant{},
+		mux:          http.NewServeMux(),
+	}
+	s.mux.HandleFunc("/.well-known/openid-configuration", s.discovery)
+	s.mux.HandleFunc("/token", s.token)
+	s.mux.HandleFunc("/jwks", s.jwks)
+	s.Server = httptest.NewServer(s.mux)
+	return s
+}
+
+// HandleFunc registers an additional endpoint, such as a profile API
+func (s *Server) HandleFunc(pattern string, handler http.HandlerFunc) {
+	s.mux.HandleFunc(pattern, handler)
+}
+
+// Config returns the client configuration for the provider
+func (s *Server) Config(redirectURL string) oidc.Config {
+	return oidc.Config{
+		Issuer:       s.URL,
+		ClientID:     s.ClientID,
+		ClientSecret: s.ClientSecret,
+		RedirectURL:  redirectURL,
+		AuthURL:      s.URL + "/authorize",
+		TokenURL:     s.URL + "/token",
+		JWKSURL:      s.URL + "/jwks",
+	}
+}
+
+// Authorize simulates the user approving the authorization request at
+// authURL. It returns the code and state the provider would redirect back
+// with. claims are added to the ID token issued for the code.
+func (s *Server) Authorize(authURL string, claims map[string]interface{}) (code, state string, err error) {
+	u, err := url.Parse(authURL)
+	if err != nil {
+		return "", "", err
+	}
+	q := u.Query()
+	if q.Get("client_id") != s.ClientID || q.Get("response_type") != "code" {
+		return "", "", errors.New("oidctest: invalid authorization request")
+	}
+
+	c := map[string]interface{}{
+		"iss":   s.URL,
+		"aud":   s.ClientID,
+		"iat":   time.Now().Unix(),
+		"exp":   time.Now().Add(time.Hour).Unix(),
+		"nonce": q.Get("nonce"),
+	}
+	for k, v := range claims {
+		c[k] = v
+	}
+
+	b := make([]byte, 16)
+	rand.Read(b)
+	code = base64.RawURLEncoding.EncodeToString(b)
+
+	s.mu.Lock()
+	s.codes[code] = grant{redirectURI: q.Get("redirect_uri"), claims: c}
+	s.mu.Unlock()
+	return code, q.Get("state"), nil
+}
+
+// Sign returns an ID token signed by the provider's key
+func (s *Server) Sign(claims map[string]interface{}) string {
+	h, _ := json.Marshal(map[string]string{"alg": "RS256", "typ": "JWT", "kid": s.KeyID})
+	p, _ := json.Marshal(claims)
+	signed := base64.RawURLEncoding.EncodeToString(h) + "." + base64.RawURLEncoding.EncodeToString(p)
+	digest := sha256.Sum256([]byte(signed))
+	sig, _ := rsa.SignPKCS1v15(rand.Reader, s.key, crypto.SHA256, digest[:])
+	return signed + "." + base64.RawURLEncoding.EncodeToString(sig)
+}
+
+func (s *Server) discovery(w http.ResponseWriter, r *http.Request) {
+	json.NewEncoder(w).Encode(map[string]string{
+		"issuer":                 s.URL,
+		"authorization_endpoint": s.URL + "/authorize",
+		"token_endpoint":         s.URL + "/token",
+		"jwks_uri":               s.URL + "/jwks",
+	})
+}
+
+func (s *Server) token(w http.ResponseWriter, r *http.Request) {
+	r.ParseForm()
+	if r.PostForm.Get("client_id") != s.ClientID || r.PostForm.Get("client_secret") != s.ClientSecret {
+		tokenError(w, http.StatusUnauthorized, "invalid_client")
+		return
+	}
+	s.mu.Lock()
+	g, ok := s.codes[r.PostForm.Get("code")]
+	delete(s.codes, r.PostForm.Get("code"))
+	s.mu.Unlock()
+	if !ok || r.PostForm.Get("grant_type") != "authorization_code" || g.redirectURI != r.PostForm.Get("redirect_uri") {
+		tokenError(w, http.StatusBadRequest, "invalid_grant")
+		return
+	}
+	json.NewEncoder(w).Encode(oidc.Token{
+		AccessToken: "access-" + r.PostForm.Get("code"),
+		TokenType:   "Bearer",
+		IDToken:     s.Sign(g.claims),
+		ExpiresIn:   3600,
+	})
+}
+
+func (s *Server) jwks(w http.ResponseWriter, r *http.Request) {
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"keys": []map[string]string{
+			{
+				"kty": "RSA",
+				"kid": s.KeyID,
+				"use": "sig",
+				"alg": "RS256",
+				"n":   base64.RawURLEncoding.EncodeToString(s.key.N.Bytes()),
+				"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(s.key.E)).Bytes()),
+			},
+		},
+	})
+}
+
+func tokenError(w http.ResponseWriter, status int, code string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(map[string]string{"error": code})
+}
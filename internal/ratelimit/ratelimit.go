@@ -0,0 +1,233 @@
+// Package ratelimit limits gRPC requests with token buckets keyed by client
+// IP, method and optionally the email in the request.
+package ratelimit
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/isaiahwong/accounts-go/internal/common"
+	"github.com/isaiahwong/accounts-go/internal/common/log"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// XForwardedFor is the metadata key holding the client's IP
+const XForwardedFor = "x-forwarded-for"
+
+// RetryAfter is the header metadata key set on limited requests to the
+// number of seconds before the request may be retried
+const RetryAfter = "retry-after"
+
+// Limit defines a token bucket. A zero Rate does not limit.
+type Limit struct {
+	// Rate is the number of tokens added per second
+	Rate float64 `json:"rate"`
+	// Burst is the bucket's capacity
+	Burst int `json:"burst"`
+	// PerEmail also limits requests by the email they carry, across IPs
+	PerEmail bool `json:"per_email"`
+}
+
+// Config holds the limits applied to each method. Methods are named without
+// the service, e.g. "SignUp".
+type Config struct {
+	Default Limit            `json:"default"`
+	Methods map[string]Limit `json:"methods"`
+}
+
+// DefaultConfig returns strict limits for methods which check credentials
+// or send mail and a generous limit for the rest
+func DefaultConfig() Config {
+	strict := Limit{Rate: 1.0 / 60, Burst: 5, PerEmail: true}
+	return Config{
+		Default: Limit{Rate: 10, Burst: 50},
+		Methods: map[string]Limit{
+			"SignUp":               strict,
+			"Authenticate":         {Rate: 1.0 / 12, Burst: 10, PerEmail: true},
+			"EmailExists":          {Rate: 1.0 / 6, Burst: 10},
+			"AccountExists":        {Rate: 1.0 / 6, Burst: 10},
+			"RequestPasswordReset": strict,
+			"ResendVerification":   strict,
+			"RequestMagicLink":     strict,
+			"RequestLoginCode":     strict,
+			"RequestEmailChange":   strict,
+			"Introspect":           {Rate: 100, Burst: 200},
+		},
+	}
+}
+
+// ParseConfig decodes a JSON config. Methods left out use DefaultConfig.
+func ParseConfig(data []byte) (Config, error) {
+	c := DefaultConfig()
+	var p Config
+	if err := json.Unmarshal(data, &p); err != nil {
+		return c, fmt.Errorf("ratelimit: %v", err)
+	}
+	if p.Default != (Limit{}) {
+		c.Default = p.Default
+	}
+	for m, l := range p.Methods {
+		c.Methods[m] = l
+	}
+	return c, nil
+}
+
+// limit returns the limit for a full gRPC method name
+func (c Config) limit(fullMethod string) Limit {
+	m := fullMethod[strings.LastIndex(fullMethod, "/")+1:]
+	if l, ok := c.Methods[m]; ok {
+		return l
+	}
+	return c.Default
+}
+
+// Backend stores token buckets. Implementations shared between replicas,
+// such as one backed by Redis, enforce limits across the deployment.
+type Backend interface {
+	// Take removes a token from the bucket for key, reporting whether one
+	// was available and if not, how long until one is
+	Take(ctx context.Context, key string, l Limit) (bool, time.Duration, error)
+}
+
+// Limiter limits requests per Config
+type Limiter struct {
+	backend Backend
+	config  Config
+	logger  log.Logger
+}
+
+// New returns a Limiter storing buckets in b
+func New(b Backend, c Config, l log.Logger) *Limiter {
+	return &Limiter{backend: b, config: c, logger: l}
+}
+
+// emailRequest is implemented by requests carrying an email
+type emailRequest interface {
+	GetEmail() string
+}
+
+// UnaryServerInterceptor returns an interceptor rejecting requests over
+// their limit with ResourceExhausted. Requests are let through if the
+// backend fails.
+func (lim *Limiter) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		l := lim.config.limit(info.FullMethod)
+		if l.Rate <= 0 {
+			return handler(ctx, req)
+		}
+
+		keys := []string{info.FullMethod + "|ip:" + clientIP(ctx)}
+		if r, ok := req.(emailRequest); ok && l.PerEmail {
+			if e := strings.ToLower(strings.TrimSpace(r.GetEmail())); e != "" {
+				keys = append(keys, info.FullMethod+"|email:"+e)
+			}
+		}
+
+		for _, k := range keys {
+			ok, wait, err := lim.backend.Take(ctx, k, l)
+			if err != nil {
+				lim.logger.Errorf("ratelimit: %v", err)
+				return handler(ctx, req)
+			}
+			if !ok {
+				secs := int(math.Ceil(wait.Seconds()))
+				grpc.SetHeader(ctx, metadata.Pairs(RetryAfter, strconv.Itoa(secs)))
+				return nil, status.Errorf(codes.ResourceExhausted, "Too many requests, retry after %d seconds", secs)
+			}
+		}
+		return handler(ctx, req)
+	}
+}
+
+// clientIP returns the first address in x-forwarded-for, falling back to
+// the peer's address
+func clientIP(ctx context.Context) string {
+	if xff := common.GetMetadataValue(ctx, XForwardedFor); xff != "" {
+		return strings.TrimSpace(strings.Split(xff, ",")[0])
+	}
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		host, _, err := net.SplitHostPort(p.Addr.String())
+		if err != nil {
+			return p.Addr.String()
+		}
+		return host
+	}
+	return ""
+}
+
+type bucket struct {
+	tokens float64
+	last   time.Time
+	// full is when the bucket will have refilled
+	full time.Time
+}
+
+// MemoryBackend keeps buckets in process. Limits are per replica.
+type MemoryBackend struct {
+	mu      sync.Mutex
+	buckets map[string]*bucket
+	takes   int
+	now     func() time.Time
+}
+
+// NewMemoryBackend returns an empty MemoryBackend
+func NewMemoryBackend() *MemoryBackend {
+	return &MemoryBackend{
+		buckets: map[string]*bucket{},
+		now:     time.Now,
+	}
+}
+
+// pruneInterval is the number of takes between removing full buckets
+const pruneInterval = 1024
+
+// Take implements Backend
+func (m *MemoryBackend) Take(ctx context.Context, key string, l Limit) (bool, time.Duration, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	now := m.now()
+	m.takes++
+	if m.takes%pruneInterval == 0 {
+		m.prune(now)
+	}
+
+	b, ok := m.buckets[key]
+	if !ok {
+		b = &bucket{tokens: float64(l.Burst), last: now}
+		m.buckets[key] = b
+	}
+	b.tokens = math.Min(float64(l.Burst), b.tokens+now.Sub(b.last).Seconds()*l.Rate)
+	b.last = now
+
+	allowed := b.tokens >= 1
+	if allowed {
+		b.tokens--
+	}
+	b.full = now.Add(time.Duration((float64(l.Burst) - b.tokens) / l.Rate * float64(time.Second)))
+	if allowed {
+		return true, 0, nil
+	}
+	wait := time.Duration((1 - b.tokens) / l.Rate * float64(time.Second))
+	return false, wait, nil
+}
+
+// prune removes buckets which have refilled as they are the same as new ones
+func (m *MemoryBackend) prune(now time.Time) {
+	for k, b := range m.buckets {
+		if now.After(b.full) {
+			delete(m.buckets, k)
+		}
+	}
+}
@@ -0,0 +1,128 @@
+package ratelimit
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/isaiahwong/accounts-go/internal/common/log"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+type emailReq struct{ email string }
+
+func (r *emailReq) GetEmail() string { return r.email }
+
+// stream records headers set by the interceptor
+type stream struct {
+	grpc.ServerTransportStream
+	header metadata.MD
+}
+
+func (s *stream) Method() string { return "" }
+
+func (s *stream) SetHeader(md metadata.MD) error {
+	s.header = metadata.Join(s.header, md)
+	return nil
+}
+
+type failingBackend struct{}
+
+func (failingBackend) Take(ctx context.Context, key string, l Limit) (bool, time.Duration, error) {
+	return false, 0, errors.New("unavailable")
+}
+
+func TestMemoryBackend(t *testing.T) {
+	now := time.Now()
+	b := NewMemoryBackend()
+	b.now = func() time.Time { return now }
+	l := Limit{Rate: 1, Burst: 2}
+
+	for i := 0; i < 2; i++ {
+		ok, _, err := b.Take(nil, "k", l)
+		assert.NoError(t, err)
+		assert.True(t, ok)
+	}
+	ok, wait, _ := b.Take(nil, "k", l)
+	assert.False(t, ok)
+	assert.Equal(t, time.Second, wait)
+
+	// Other keys have their own bucket
+	ok, _, _ = b.Take(nil, "other", l)
+	assert.True(t, ok)
+
+	now = now.Add(time.Second)
+	ok, _, _ = b.Take(nil, "k", l)
+	assert.True(t, ok)
+
+	// Refilled buckets are pruned
+	now = now.Add(time.Minute)
+	b.prune(now)
+	assert.Empty(t, b.buckets)
+}
+
+func TestInterceptor(t *testing.T) {
+	c := DefaultConfig()
+	c.Methods["Authenticate"] = Limit{Rate: 1.0 / 60, Burst: 1, PerEmail: true}
+	lim := New(NewMemoryBackend(), c, log.NewLogrusLogger())
+	intercept := lim.UnaryServerInterceptor()
+
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return "ok", nil
+	}
+	call := func(ip, email string) (*stream, error) {
+		s := &stream{}
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(XForwardedFor, ip))
+		ctx = grpc.NewContextWithServerTransportStream(ctx, s)
+		info := &grpc.UnaryServerInfo{FullMethod: "/api.accounts.v1.AccountsService/Authenticate"}
+		_, err := intercept(ctx, &emailReq{email}, info, handler)
+		return s, err
+	}
+
+	t.Run("Per IP", func(t *testing.T) {
+		_, err := call("10.0.0.1", "a@example.com")
+		assert.NoError(t, err)
+		s, err := call("10.0.0.1", "b@example.com")
+		assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+		assert.Equal(t, []string{"60"}, s.header.Get(RetryAfter))
+	})
+
+	t.Run("Per email", func(t *testing.T) {
+		_, err := call("10.0.0.2", "c@example.com")
+		assert.NoError(t, err)
+		_, err = call("10.0.0.3", "C@example.com")
+		assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	})
+
+	t.Run("Per method", func(t *testing.T) {
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(XForwardedFor, "10.0.0.1"))
+		info := &grpc.UnaryServerInfo{FullMethod: "/api.accounts.v1.AccountsService/Introspect"}
+		_, err := intercept(ctx, nil, info, handler)
+		assert.NoError(t, err)
+	})
+
+	t.Run("Fails open", func(t *testing.T) {
+		lim := New(failingBackend{}, c, log.NewLogrusLogger())
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(XForwardedFor, "10.0.0.1"))
+		info := &grpc.UnaryServerInfo{FullMethod: "/api.accounts.v1.AccountsService/Authenticate"}
+		resp, err := lim.UnaryServerInterceptor()(ctx, nil, info, handler)
+		assert.NoError(t, err)
+		assert.Equal(t, "ok", resp)
+	})
+}
+
+func TestParseConfig(t *testing.T) {
+	c, err := ParseConfig([]byte(`{"methods": {"SignUp": {"rate": 2, "burst": 3}}}`))
+	assert.NoError(t, err)
+	assert.Equal(t, Limit{Rate: 2, Burst: 3}, c.limit("/api.accounts.v1.AccountsService/SignUp"))
+	assert.Equal(t, DefaultConfig().Default, c.limit("/api.accounts.v1.AccountsService/Other"))
+	assert.Equal(t, DefaultConfig().Methods["Introspect"], c.limit("/api.accounts.v1.AccountsService/Introspect"))
+
+	_, err = ParseConfig([]byte(`{`))
+	assert.Error(t, err)
+}
@@ -2,6 +2,7 @@ package server
 
 import (
 	"github.com/isaiahwong/accounts-go/internal/common/log"
+	"github.com/isaiahwong/accounts-go/internal/ratelimit"
 	"github.com/isaiahwong/accounts-go/internal/store"
 )
 
@@ -11,6 +12,7 @@ type serverOptions struct {
 	production bool
 	logger     log.Logger
 	store      store.DataStore
+	limiter    *ratelimit.Limiter
 }
 
 // Option is an option that can be given to a Server on construction.
@@ -62,3 +64,10 @@ func WithDataStore(s store.DataStore) Option {
 		o.store = s
 	}
 }
+
+// WithRateLimiter an Option which limits the rate of unary requests
+func WithRateLimiter(l *ratelimit.Limiter) Option {
+	return func(o *serverOptions) {
+		o.limiter = l
+	}
+}
@@ -77,11 +77,17 @@ func New(opt ...Option) (*Server, error) {
 		reflect.ValueOf(o).Elem().Set(reflect.ValueOf(i))
 	}(opts.logger, &l)
 
+	interceptors := []grpc.UnaryServerInterceptor{
+		grpc_logrus.UnaryServerInterceptor(logrus.NewEntry(l), grpc_logrus.WithDecider(LoggerDecider)),
+	}
+	// Limited requests are still logged
+	if opts.limiter != nil {
+		interceptors = append(interceptors, opts.limiter.UnaryServerInterceptor())
+	}
+
 	// Create a new gRPC server
 	gs := grpc.NewServer(
-		grpc.UnaryInterceptor(grpc_middleware.ChainUnaryServer(
-			grpc_logrus.UnaryServerInterceptor(logrus.NewEntry(l), grpc_logrus.WithDecider(LoggerDecider)),
-		)),
+		grpc.UnaryInterceptor(grpc_middleware.ChainUnaryServer(interceptors...)),
 	)
 
 	// Create a new network listener
@@ -0,0 +1,67 @@
+// Package sms provides text message senders for development and tests.
+// Messages are recorded rather than delivered.
+package sms
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/isaiahwong/accounts-go/internal/common/log"
+)
+
+// Message is a text message recorded by FileSender
+type Message struct {
+	To   string    `json:"to"`
+	Body string    `json:"body"`
+	Sent time.Time `json:"sent"`
+}
+
+// LogSender writes messages to a logger
+type LogSender struct {
+	logger log.Logger
+}
+
+// NewLogSender returns a LogSender writing to l
+func NewLogSender(l log.Logger) *LogSender {
+	return &LogSender{logger: l}
+}
+
+// SendSMS logs the message
+func (s *LogSender) SendSMS(_ context.Context, to, body string) error {
+	s.logger.Infof("sms: to %v: %v", to, body)
+	return nil
+}
+
+// FileSender appends messages to a file as JSON lines
+type FileSender struct {
+	mu   sync.Mutex
+	path string
+}
+
+// NewFileSender returns a FileSender appending to path
+func NewFileSender(path string) *FileSender {
+	return &FileSender{path: path}
+}
+
+// SendSMS appends the message to the file
+func (s *FileSender) SendSMS(_ context.Context, to, body string) error {
+	b, err := json.Marshal(Message{To: to, Body: body, Sent: time.Now()})
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	f, err := os.OpenFile(s.path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0600)
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(append(b, '\n')); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
@@ -0,0 +1,41 @@
+package sms
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFileSender(t *testing.T) {
+	dir, err := ioutil.TempDir("", "sms")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "sms.log")
+	s := NewFileSender(path)
+	assert.NoError(t, s.SendSMS(context.Background(), "+6591234567", "first"))
+	assert.NoError(t, s.SendSMS(context.Background(), "+6591234567", "second"))
+
+	f, err := os.Open(path)
+	assert.NoError(t, err)
+	defer f.Close()
+
+	var got []Message
+	sc := bufio.NewScanner(f)
+	for sc.Scan() {
+		var m Message
+		assert.NoError(t, json.Unmarshal(sc.Bytes(), &m))
+		got = append(got, m)
+	}
+	if assert.Len(t, got, 2) {
+		assert.Equal(t, "+6591234567", got[0].To)
+		assert.Equal(t, "first", got[0].Body)
+		assert.Equal(t, "second", got[1].Body)
+	}
+}
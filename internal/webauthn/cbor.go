@@ -0,0 +1,126 @@
+package webauthn
+
+import (
+	"encoding/binary"
+	"errors"
+)
+
+// ErrCBOR is returned when data is not valid or supported CBOR
+var ErrCBOR = errors.New("webauthn: malformed cbor")
+
+// maxDepth bounds nesting of arrays and maps
+const maxDepth = 16
+
+// decodeCBOR decodes the first CBOR data item in b and returns the remaining
+// bytes. Only the subset used by WebAuthn is supported: integers, byte and
+// text strings, arrays, maps and simple values. Integers are returned as
+// int64, maps as map[interface{}]interface{}.
+func decodeCBOR(b []byte) (interface{}, []byte, error) {
+	return decodeItem(b, 0)
+}
+
+func decodeItem(b []byte, depth int) (interface{}, []byte, error) {
+	if depth > maxDepth || len(b) < 1 {
+		return nil, nil, ErrCBOR
+	}
+	major := b[0] >> 5
+	info := b[0] & 0x1f
+	b = b[1:]
+
+	// Simple values
+	if major == 7 {
+		switch info {
+		case 20:
+			return false, b, nil
+		case 21:
+			return true, b, nil
+		case 22, 23:
+			return nil, b, nil
+		}
+		return nil, nil, ErrCBOR
+	}
+
+	n, b, err := decodeArgument(info, b)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	switch major {
+	case 0:
+		if n > 1<<63-1 {
+			return nil, nil, ErrCBOR
+		}
+		return int64(n), b, nil
+	case 1:
+		if n > 1<<63-1 {
+			return nil, nil, ErrCBOR
+		}
+		return -1 - int64(n), b, nil
+	case 2, 3:
+		if n > uint64(len(b)) {
+			return nil, nil, ErrCBOR
+		}
+		v := make([]byte, n)
+		copy(v, b[:n])
+		if major == 3 {
+			return string(v), b[n:], nil
+		}
+		return v, b[n:], nil
+	case 4:
+		// Each item takes at least a byte
+		if n > uint64(len(b)) {
+			return nil, nil, ErrCBOR
+		}
+		arr := make([]interface{}, 0, n)
+		for i := uint64(0); i < n; i++ {
+			var v interface{}
+			v, b, err = decodeItem(b, depth+1)
+			if err != nil {
+				return nil, nil, err
+			}
+			arr = append(arr, v)
+		}
+		return arr, b, nil
+	case 5:
+		if n > uint64(len(b)) {
+			return nil, nil, ErrCBOR
+		}
+		m := make(map[interface{}]interface{}, n)
+		for i := uint64(0); i < n; i++ {
+			var k, v interface{}
+			k, b, err = decodeItem(b, depth+1)
+			if err != nil {
+				return nil, nil, err
+			}
+			switch k.(type) {
+			case int64, string:
+			default:
+				return nil, nil, ErrCBOR
+			}
+			v, b, err = decodeItem(b, depth+1)
+			if err != nil {
+				return nil, nil, err
+			}
+			m[k] = v
+		}
+		return m, b, nil
+	}
+	// Tags and indefinite lengths are not used by WebAuthn
+	return nil, nil, ErrCBOR
+}
+
+func decodeArgument(info byte, b []byte) (uint64, []byte, error) {
+	switch {
+	case info < 24:
+		return uint64(info), b, nil
+	case info == 24 && len(b) >= 1:
+		return uint64(b[0]), b[1:], nil
+	case info == 25 && len(b) >= 2:
+		return uint64(binary.BigEndian.Uint16(b)), b[2:], nil
+	case info == 26 && len(b) >= 4:
+		return uint64(binary.BigEndian.Uint32(b)), b[4:], nil
+	case info == 27 && len(b) >= 8:
+		return binary.BigEndian.Uint64(b), b[8:], nil
+	}
+	return 0, nil, ErrCBOR
+}
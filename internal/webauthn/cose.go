@@ -0,0 +1,116 @@
+package webauthn
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/asn1"
+	"errors"
+	"math/big"
+)
+
+// COSE algorithm identifiers
+// https://www.iana.org/assignments/cose/cose.xhtml#algorithms
+const (
+	AlgES256 int64 = -7
+	AlgRS256 int64 = -257
+)
+
+// COSE key parameters
+const (
+	coseKty    int64 = 1
+	coseAlg    int64 = 3
+	coseCrv    int64 = -1
+	coseX      int64 = -2
+	coseY      int64 = -3
+	coseRSAN   int64 = -1
+	coseRSAE   int64 = -2
+	coseKtyEC2 int64 = 2
+	coseKtyRSA int64 = 3
+	coseP256   int64 = 1
+)
+
+// ErrUnsupportedKey is returned for COSE keys other than ES256 and RS256
+var ErrUnsupportedKey = errors.New("webauthn: unsupported public key")
+
+// ErrSignature is returned when a signature does not verify
+var ErrSignature = errors.New("webauthn: invalid signature")
+
+// publicKey is a parsed COSE_Key
+type publicKey struct {
+	alg int64
+	key crypto.PublicKey
+}
+
+// parsePublicKey parses a CBOR encoded COSE_Key
+func parsePublicKey(b []byte) (*publicKey, error) {
+	v, _, err := decodeCBOR(b)
+	if err != nil {
+		return nil, err
+	}
+	m, ok := v.(map[interface{}]interface{})
+	if !ok {
+		return nil, ErrCBOR
+	}
+	kty, _ := m[coseKty].(int64)
+	alg, _ := m[coseAlg].(int64)
+
+	switch {
+	case kty == coseKtyEC2 && alg == AlgES256:
+		crv, _ := m[coseCrv].(int64)
+		x, _ := m[coseX].([]byte)
+		y, _ := m[coseY].([]byte)
+		if crv != coseP256 || len(x) != 32 || len(y) != 32 {
+			return nil, ErrUnsupportedKey
+		}
+		pub := &ecdsa.PublicKey{
+			Curve: elliptic.P256(),
+			X:     new(big.Int).SetBytes(x),
+			Y:     new(big.Int).SetBytes(y),
+		}
+		if !pub.Curve.IsOnCurve(pub.X, pub.Y) {
+			return nil, ErrUnsupportedKey
+		}
+		return &publicKey{alg: alg, key: pub}, nil
+
+	case kty == coseKtyRSA && alg == AlgRS256:
+		n, _ := m[coseRSAN].([]byte)
+		e, _ := m[coseRSAE].([]byte)
+		if len(n) < 256 || len(e) == 0 || len(e) > 4 {
+			return nil, ErrUnsupportedKey
+		}
+		pub := &rsa.PublicKey{
+			N: new(big.Int).SetBytes(n),
+			E: int(new(big.Int).SetBytes(e).Int64()),
+		}
+		return &publicKey{alg: alg, key: pub}, nil
+	}
+	return nil, ErrUnsupportedKey
+}
+
+// verify checks sig over data
+func (k *publicKey) verify(data, sig []byte) error {
+	digest := sha256.Sum256(data)
+	switch pub := k.key.(type) {
+	case *ecdsa.PublicKey:
+		var es struct {
+			R, S *big.Int
+		}
+		rest, err := asn1.Unmarshal(sig, &es)
+		if err != nil || len(rest) > 0 {
+			return ErrSignature
+		}
+		if !ecdsa.Verify(pub, digest[:], es.R, es.S) {
+			return ErrSignature
+		}
+		return nil
+	case *rsa.PublicKey:
+		if err := rsa.VerifyPKCS1v15(pub, crypto.SHA256, digest[:], sig); err != nil {
+			return ErrSignature
+		}
+		return nil
+	}
+	return ErrUnsupportedKey
+}
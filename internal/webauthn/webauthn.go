@@ -0,0 +1,463 @@
+// Package webauthn implements the relying party side of Web Authentication
+// (https://www.w3.org/TR/webauthn-2/) for passkey registration and login.
+//
+// Only "none" and packed self attestation are accepted, which is what
+// browsers send when attestation conveyance is "none".
+package webauthn
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"strings"
+	"time"
+)
+
+// Authenticator data flags
+const (
+	flagUserPresent  byte = 0x01
+	flagUserVerified byte = 0x04
+	flagAttested     byte = 0x40
+)
+
+// ChallengeSize is the number of random bytes in a ceremony challenge
+const ChallengeSize = 32
+
+// Errors returned when verifying a ceremony
+var (
+	ErrMalformed     = errors.New("webauthn: malformed credential")
+	ErrType          = errors.New("webauthn: unexpected client data type")
+	ErrChallenge     = errors.New("webauthn: challenge mismatch")
+	ErrOrigin        = errors.New("webauthn: origin not allowed")
+	ErrRPID          = errors.New("webauthn: relying party id mismatch")
+	ErrUserPresence  = errors.New("webauthn: user not present")
+	ErrAttestation   = errors.New("webauthn: unsupported attestation")
+	ErrSignCount     = errors.New("webauthn: signature counter did not increase")
+	ErrNoCredentials = errors.New("webauthn: no attested credential data")
+)
+
+// Config defines the relying party
+type Config struct {
+	// RPID is the relying party identifier, usually the registrable domain
+	RPID string
+	// RPName is displayed by the authenticator
+	RPName string
+	// Origins lists the origins allowed to perform ceremonies
+	Origins []string
+	// Timeout is the time a ceremony is valid for
+	Timeout time.Duration
+}
+
+// WebAuthn verifies registration and assertion ceremonies for a relying party
+type WebAuthn struct {
+	config Config
+	rpHash [32]byte
+}
+
+// New returns a WebAuthn for config
+func New(config Config) *WebAuthn {
+	if config.Timeout == 0 {
+		config.Timeout = 5 * time.Minute
+	}
+	return &WebAuthn{
+		config: config,
+		rpHash: sha256.Sum256([]byte(config.RPID)),
+	}
+}
+
+// Timeout returns the time a ceremony is valid for
+func (w *WebAuthn) Timeout() time.Duration {
+	return w.config.Timeout
+}
+
+// NewChallenge returns a random ceremony challenge
+func NewChallenge() ([]byte, error) {
+	b := make([]byte, ChallengeSize)
+	if _, err := rand.Read(b); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// User identifies the account a credential is registered for
+type User struct {
+	ID          []byte
+	Name        string
+	DisplayName string
+}
+
+// Credential is a verified public key credential
+type Credential struct {
+	ID           []byte
+	PublicKey    []byte
+	SignCount    uint32
+	AAGUID       []byte
+	UserVerified bool
+}
+
+// RelyingParty is PublicKeyCredentialRpEntity
+type RelyingParty struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// UserEntity is PublicKeyCredentialUserEntity
+type UserEntity struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	DisplayName string `json:"displayName"`
+}
+
+// CredentialParameter is PublicKeyCredentialParameters
+type CredentialParameter struct {
+	Type string `json:"type"`
+	Alg  int64  `json:"alg"`
+}
+
+// CredentialDescriptor is PublicKeyCredentialDescriptor
+type CredentialDescriptor struct {
+	Type string `json:"type"`
+	ID   string `json:"id"`
+}
+
+// AuthenticatorSelection is AuthenticatorSelectionCriteria
+type AuthenticatorSelection struct {
+	ResidentKey      string `json:"residentKey"`
+	UserVerification string `json:"userVerification"`
+}
+
+// CreationOptions is PublicKeyCredentialCreationOptions with binary values
+// encoded as base64url
+type CreationOptions struct {
+	Challenge              string                 `json:"challenge"`
+	RP                     RelyingParty           `json:"rp"`
+	User                   UserEntity             `json:"user"`
+	PubKeyCredParams       []CredentialParameter  `json:"pubKeyCredParams"`
+	Timeout                int64                  `json:"timeout"`
+	ExcludeCredentials     []CredentialDescriptor `json:"excludeCredentials"`
+	AuthenticatorSelection AuthenticatorSelection `json:"authenticatorSelection"`
+	Attestation            string                 `json:"attestation"`
+}
+
+// RequestOptions is PublicKeyCredentialRequestOptions with binary values
+// encoded as base64url
+type RequestOptions struct {
+	Challenge        string                 `json:"challenge"`
+	Timeout          int64                  `json:"timeout"`
+	RPID             string                 `json:"rpId"`
+	AllowCredentials []CredentialDescriptor `json:"allowCredentials"`
+	UserVerification string                 `json:"userVerification"`
+}
+
+// CreationOptions returns the options passed to navigator.credentials.create.
+// exclude lists credential IDs already registered for user.
+func (w *WebAuthn) CreationOptions(user User, challenge []byte, exclude [][]byte) *CreationOptions {
+	return &CreationOptions{
+		Challenge: Encode(challenge),
+		RP: RelyingParty{
+			ID:   w.config.RPID,
+			Name: w.config.RPName,
+		},
+		User: UserEntity{
+			ID:          Encode(user.ID),
+			Name:        user.Name,
+			DisplayName: user.DisplayName,
+		},
+		PubKeyCredParams: []CredentialParameter{
+			{Type: "public-key", Alg: AlgES256},
+			{Type: "public-key", Alg: AlgRS256},
+		},
+		Timeout:            w.config.Timeout.Milliseconds(),
+		ExcludeCredentials: descriptors(exclude),
+		AuthenticatorSelection: AuthenticatorSelection{
+			ResidentKey:      "preferred",
+			UserVerification: "preferred",
+		},
+		Attestation: "none",
+	}
+}
+
+// RequestOptions returns the options passed to navigator.credentials.get
+func (w *WebAuthn) RequestOptions(challenge []byte, allow [][]byte) *RequestOptions {
+	return &RequestOptions{
+		Challenge:        Encode(challenge),
+		Timeout:          w.config.Timeout.Milliseconds(),
+		RPID:             w.config.RPID,
+		AllowCredentials: descriptors(allow),
+		UserVerification: "preferred",
+	}
+}
+
+func descriptors(ids [][]byte) []CredentialDescriptor {
+	d := make([]CredentialDescriptor, 0, len(ids))
+	for _, id := range ids {
+		d = append(d, CredentialDescriptor{Type: "public-key", ID: Encode(id)})
+	}
+	return d
+}
+
+// RegistrationResponse is the JSON encoding of a PublicKeyCredential
+// returned by navigator.credentials.create
+type RegistrationResponse struct {
+	ID       string `json:"id"`
+	RawID    string `json:"rawId"`
+	Type     string `json:"type"`
+	Response struct {
+		ClientDataJSON    string `json:"clientDataJSON"`
+		AttestationObject string `json:"attestationObject"`
+	} `json:"response"`
+}
+
+// AssertionResponse is the JSON encoding of a PublicKeyCredential returned
+// by navigator.credentials.get
+type AssertionResponse struct {
+	ID       string `json:"id"`
+	RawID    string `json:"rawId"`
+	Type     string `json:"type"`
+	Response struct {
+		ClientDataJSON    string `json:"clientDataJSON"`
+		AuthenticatorData string `json:"authenticatorData"`
+		Signature         string `json:"signature"`
+		UserHandle        string `json:"userHandle"`
+	} `json:"response"`
+}
+
+// Assertion is a parsed AssertionResponse
+type Assertion struct {
+	CredentialID      []byte
+	UserHandle        []byte
+	clientDataJSON    []byte
+	authenticatorData []byte
+	signature         []byte
+}
+
+// clientData is CollectedClientData
+type clientData struct {
+	Type      string `json:"type"`
+	Challenge string `json:"challenge"`
+	Origin    string `json:"origin"`
+}
+
+// authenticatorData is the parsed authenticator data structure
+type authenticatorData struct {
+	rpIDHash     []byte
+	flags        byte
+	signCount    uint32
+	aaguid       []byte
+	credentialID []byte
+	publicKey    []byte
+}
+
+// VerifyRegistration verifies a RegistrationResponse against the challenge
+// issued by CreationOptions and returns the new credential
+func (w *WebAuthn) VerifyRegistration(raw []byte, challenge []byte) (*Credential, error) {
+	var r RegistrationResponse
+	if err := json.Unmarshal(raw, &r); err != nil || r.Type != "public-key" {
+		return nil, ErrMalformed
+	}
+	cdj, err := Decode(r.Response.ClientDataJSON)
+	if err != nil {
+		return nil, ErrMalformed
+	}
+	if err := w.verifyClientData(cdj, "webauthn.create", challenge); err != nil {
+		return nil, err
+	}
+	ao, err := Decode(r.Response.AttestationObject)
+	if err != nil {
+		return nil, ErrMalformed
+	}
+	v, _, err := decodeCBOR(ao)
+	if err != nil {
+		return nil, err
+	}
+	obj, ok := v.(map[interface{}]interface{})
+	if !ok {
+		return nil, ErrMalformed
+	}
+	format, _ := obj["fmt"].(string)
+	attStmt, _ := obj["attStmt"].(map[interface{}]interface{})
+	rawAuthData, _ := obj["authData"].([]byte)
+
+	ad, err := parseAuthenticatorData(rawAuthData)
+	if err != nil {
+		return nil, err
+	}
+	if err := w.verifyAuthenticatorData(ad); err != nil {
+		return nil, err
+	}
+	if ad.flags&flagAttested == 0 || len(ad.credentialID) == 0 {
+		return nil, ErrNoCredentials
+	}
+	pub, err := parsePublicKey(ad.publicKey)
+	if err != nil {
+		return nil, err
+	}
+
+	switch format {
+	case "none":
+		if len(attStmt) > 0 {
+			return nil, ErrAttestation
+		}
+	case "packed":
+		// Self attestation is signed by the credential key itself
+		alg, _ := attStmt["alg"].(int64)
+		sig, _ := attStmt["sig"].([]byte)
+		if _, ok := attStmt["x5c"]; ok || alg != pub.alg {
+			return nil, ErrAttestation
+		}
+		hash := sha256.Sum256(cdj)
+		if err := pub.verify(append(append([]byte{}, rawAuthData...), hash[:]...), sig); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, ErrAttestation
+	}
+
+	if id, err := Decode(r.RawID); err != nil || !bytes.Equal(id, ad.credentialID) {
+		return nil, ErrMalformed
+	}
+
+	return &Credential{
+		ID:           ad.credentialID,
+		PublicKey:    ad.publicKey,
+		SignCount:    ad.signCount,
+		AAGUID:       ad.aaguid,
+		UserVerified: ad.flags&flagUserVerified != 0,
+	}, nil
+}
+
+// ParseAssertion parses an AssertionResponse so the credential it claims
+// can be looked up before verification
+func ParseAssertion(raw []byte) (*Assertion, error) {
+	var r AssertionResponse
+	if err := json.Unmarshal(raw, &r); err != nil || r.Type != "public-key" {
+		return nil, ErrMalformed
+	}
+	a := &Assertion{}
+	var err error
+	if a.CredentialID, err = Decode(r.RawID); err != nil || len(a.CredentialID) == 0 {
+		return nil, ErrMalformed
+	}
+	if a.clientDataJSON, err = Decode(r.Response.ClientDataJSON); err != nil {
+		return nil, ErrMalformed
+	}
+	if a.authenticatorData, err = Decode(r.Response.AuthenticatorData); err != nil {
+		return nil, ErrMalformed
+	}
+	if a.signature, err = Decode(r.Response.Signature); err != nil {
+		return nil, ErrMalformed
+	}
+	if a.UserHandle, err = Decode(r.Response.UserHandle); err != nil {
+		return nil, ErrMalformed
+	}
+	return a, nil
+}
+
+// VerifyAssertion verifies a against the challenge issued by RequestOptions
+// and the stored credential. The credential's new signature counter is
+// returned along with whether the user was verified by the authenticator.
+func (w *WebAuthn) VerifyAssertion(a *Assertion, challenge []byte, cred Credential) (uint32, bool, error) {
+	if err := w.verifyClientData(a.clientDataJSON, "webauthn.get", challenge); err != nil {
+		return 0, false, err
+	}
+	ad, err := parseAuthenticatorData(a.authenticatorData)
+	if err != nil {
+		return 0, false, err
+	}
+	if err := w.verifyAuthenticatorData(ad); err != nil {
+		return 0, false, err
+	}
+	pub, err := parsePublicKey(cred.PublicKey)
+	if err != nil {
+		return 0, false, err
+	}
+	hash := sha256.Sum256(a.clientDataJSON)
+	if err := pub.verify(append(append([]byte{}, a.authenticatorData...), hash[:]...), a.signature); err != nil {
+		return 0, false, err
+	}
+	// Authenticators that do not implement counters always report zero
+	if (ad.signCount != 0 || cred.SignCount != 0) && ad.signCount <= cred.SignCount {
+		return 0, false, ErrSignCount
+	}
+	return ad.signCount, ad.flags&flagUserVerified != 0, nil
+}
+
+func (w *WebAuthn) verifyClientData(raw []byte, typ string, challenge []byte) error {
+	var cd clientData
+	if err := json.Unmarshal(raw, &cd); err != nil {
+		return ErrMalformed
+	}
+	if cd.Type != typ {
+		return ErrType
+	}
+	c, err := Decode(cd.Challenge)
+	if err != nil || len(challenge) == 0 || subtle.ConstantTimeCompare(c, challenge) != 1 {
+		return ErrChallenge
+	}
+	for _, o := range w.config.Origins {
+		if cd.Origin == o {
+			return nil
+		}
+	}
+	return ErrOrigin
+}
+
+func (w *WebAuthn) verifyAuthenticatorData(ad *authenticatorData) error {
+	if subtle.ConstantTimeCompare(ad.rpIDHash, w.rpHash[:]) != 1 {
+		return ErrRPID
+	}
+	if ad.flags&flagUserPresent == 0 {
+		return ErrUserPresence
+	}
+	return nil
+}
+
+// parseAuthenticatorData parses the authenticator data structure
+// https://www.w3.org/TR/webauthn-2/#sctn-authenticator-data
+func parseAuthenticatorData(b []byte) (*authenticatorData, error) {
+	if len(b) < 37 {
+		return nil, ErrMalformed
+	}
+	ad := &authenticatorData{
+		rpIDHash:  b[:32],
+		flags:     b[32],
+		signCount: binary.BigEndian.Uint32(b[33:37]),
+	}
+	if ad.flags&flagAttested == 0 {
+		return ad, nil
+	}
+	rest := b[37:]
+	if len(rest) < 18 {
+		return nil, ErrMalformed
+	}
+	ad.aaguid = rest[:16]
+	n := int(binary.BigEndian.Uint16(rest[16:18]))
+	rest = rest[18:]
+	if len(rest) < n {
+		return nil, ErrMalformed
+	}
+	ad.credentialID = rest[:n]
+	rest = rest[n:]
+	// The COSE key is followed by optional extensions
+	_, after, err := decodeCBOR(rest)
+	if err != nil {
+		return nil, err
+	}
+	ad.publicKey = rest[:len(rest)-len(after)]
+	return ad, nil
+}
+
+// Encode returns b as unpadded base64url
+func Encode(b []byte) string {
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+// Decode decodes base64url with or without padding
+func Decode(s string) ([]byte, error) {
+	return base64.RawURLEncoding.DecodeString(strings.TrimRight(s, "="))
+}
@@ -0,0 +1,116 @@
+package webauthn_test
+
+import (
+	"testing"
+
+	"github.com/isaiahwong/accounts-go/internal/webauthn"
+	"github.com/isaiahwong/accounts-go/internal/webauthn/webauthntest"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	rpID   = "example.com"
+	origin = "https://example.com"
+)
+
+func newRP() *webauthn.WebAuthn {
+	return webauthn.New(webauthn.Config{
+		RPID:    rpID,
+		RPName:  "Example",
+		Origins: []string{origin},
+	})
+}
+
+func register(t *testing.T, rp *webauthn.WebAuthn, a *webauthntest.Authenticator) *webauthn.Credential {
+	challenge, _ := webauthn.NewChallenge()
+	raw, err := a.Create(rp.CreationOptions(webauthn.User{ID: []byte("user")}, challenge, nil))
+	assert.NoError(t, err)
+	cred, err := rp.VerifyRegistration(raw, challenge)
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+	return cred
+}
+
+func TestRegistration(t *testing.T) {
+	rp := newRP()
+
+	t.Run("Valid", func(t *testing.T) {
+		cred := register(t, rp, webauthntest.New(rpID, origin))
+		assert.NotEmpty(t, cred.ID)
+		assert.NotEmpty(t, cred.PublicKey)
+		assert.True(t, cred.UserVerified)
+	})
+
+	t.Run("Wrong challenge", func(t *testing.T) {
+		challenge, _ := webauthn.NewChallenge()
+		other, _ := webauthn.NewChallenge()
+		raw, _ := webauthntest.New(rpID, origin).Create(rp.CreationOptions(webauthn.User{ID: []byte("user")}, challenge, nil))
+		_, err := rp.VerifyRegistration(raw, other)
+		assert.Equal(t, webauthn.ErrChallenge, err)
+	})
+
+	t.Run("Wrong origin", func(t *testing.T) {
+		challenge, _ := webauthn.NewChallenge()
+		raw, _ := webauthntest.New(rpID, "https://evil.com").Create(rp.CreationOptions(webauthn.User{ID: []byte("user")}, challenge, nil))
+		_, err := rp.VerifyRegistration(raw, challenge)
+		assert.Equal(t, webauthn.ErrOrigin, err)
+	})
+
+	t.Run("Wrong relying party", func(t *testing.T) {
+		challenge, _ := webauthn.NewChallenge()
+		raw, _ := webauthntest.New("evil.com", origin).Create(rp.CreationOptions(webauthn.User{ID: []byte("user")}, challenge, nil))
+		_, err := rp.VerifyRegistration(raw, challenge)
+		assert.Equal(t, webauthn.ErrRPID, err)
+	})
+}
+
+func TestAssertion(t *testing.T) {
+	rp := newRP()
+	a := webauthntest.New(rpID, origin)
+	cred := register(t, rp, a)
+
+	get := func(challenge []byte) *webauthn.Assertion {
+		raw, err := a.Get(rp.RequestOptions(challenge, [][]byte{cred.ID}), []byte("user"))
+		assert.NoError(t, err)
+		as, err := webauthn.ParseAssertion(raw)
+		assert.NoError(t, err)
+		return as
+	}
+
+	t.Run("Valid", func(t *testing.T) {
+		challenge, _ := webauthn.NewChallenge()
+		as := get(challenge)
+		assert.Equal(t, cred.ID, as.CredentialID)
+		count, uv, err := rp.VerifyAssertion(as, challenge, *cred)
+		assert.NoError(t, err)
+		assert.True(t, uv)
+		assert.True(t, count > cred.SignCount)
+		cred.SignCount = count
+	})
+
+	t.Run("Replayed counter", func(t *testing.T) {
+		challenge, _ := webauthn.NewChallenge()
+		as := get(challenge)
+		stale := *cred
+		stale.SignCount += 10
+		_, _, err := rp.VerifyAssertion(as, challenge, stale)
+		assert.Equal(t, webauthn.ErrSignCount, err)
+	})
+
+	t.Run("Wrong key", func(t *testing.T) {
+		challenge, _ := webauthn.NewChallenge()
+		as := get(challenge)
+		other := register(t, rp, webauthntest.New(rpID, origin))
+		_, _, err := rp.VerifyAssertion(as, challenge, webauthn.Credential{PublicKey: other.PublicKey})
+		assert.Equal(t, webauthn.ErrSignature, err)
+	})
+
+	t.Run("Wrong challenge", func(t *testing.T) {
+		challenge, _ := webauthn.NewChallenge()
+		other, _ := webauthn.NewChallenge()
+		as := get(challenge)
+		_, _, err := rp.VerifyAssertion(as, other, *cred)
+		assert.Equal(t, webauthn.ErrChallenge, err)
+	})
+}
@@ -0,0 +1,216 @@
+// Package webauthntest provides a software authenticator for testing
+// WebAuthn ceremonies without a browser.
+package webauthntest
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/asn1"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"math/big"
+
+	"github.com/isaiahwong/accounts-go/internal/webauthn"
+)
+
+// Authenticator is an ES256 platform authenticator holding credentials in
+// memory
+type Authenticator struct {
+	RPID   string
+	Origin string
+	// UserVerified sets the UV flag in authenticator data
+	UserVerified bool
+
+	keys   map[string]*ecdsa.PrivateKey
+	counts map[string]uint32
+}
+
+// New returns an Authenticator for rpID used from origin
+func New(rpID, origin string) *Authenticator {
+	return &Authenticator{
+		RPID:         rpID,
+		Origin:       origin,
+		UserVerified: true,
+		keys:         map[string]*ecdsa.PrivateKey{},
+		counts:       map[string]uint32{},
+	}
+}
+
+// Create performs navigator.credentials.create for options and returns the
+// JSON encoded PublicKeyCredential
+func (a *Authenticator) Create(options *webauthn.CreationOptions) ([]byte, error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+	id := make([]byte, 16)
+	if _, err := rand.Read(id); err != nil {
+		return nil, err
+	}
+	a.keys[string(id)] = key
+	a.counts[string(id)] = 1
+
+	cdj, err := a.clientData("webauthn.create", options.Challenge)
+	if err != nil {
+		return nil, err
+	}
+
+	// Attested credential data
+	attested := make([]byte, 18)
+	binary.BigEndian.PutUint16(attested[16:], uint16(len(id)))
+	attested = append(attested, id...)
+	attested = append(attested, coseKey(&key.PublicKey)...)
+
+	authData := append(a.authData(0x40, a.counts[string(id)]), attested...)
+	ao := encodeMap([]interface{}{
+		"fmt", "none",
+		"attStmt", []interface{}{},
+		"authData", authData,
+	})
+
+	r := webauthn.RegistrationResponse{
+		ID:    webauthn.Encode(id),
+		RawID: webauthn.Encode(id),
+		Type:  "public-key",
+	}
+	r.Response.ClientDataJSON = webauthn.Encode(cdj)
+	r.Response.AttestationObject = webauthn.Encode(ao)
+	return json.Marshal(r)
+}
+
+// Get performs navigator.credentials.get for options and returns the JSON
+// encoded PublicKeyCredential. The first allowed credential held by the
+// authenticator is used.
+func (a *Authenticator) Get(options *webauthn.RequestOptions, userHandle []byte) ([]byte, error) {
+	var id []byte
+	var key *ecdsa.PrivateKey
+	for _, c := range options.AllowCredentials {
+		b, err := webauthn.Decode(c.ID)
+		if err != nil {
+			return nil, err
+		}
+		if k, ok := a.keys[string(b)]; ok {
+			id, key = b, k
+			break
+		}
+	}
+	if key == nil {
+		return nil, errors.New("webauthntest: no matching credential")
+	}
+	a.counts[string(id)]++
+
+	cdj, err := a.clientData("webauthn.get", options.Challenge)
+	if err != nil {
+		return nil, err
+	}
+	authData := a.authData(0, a.counts[string(id)])
+	hash := sha256.Sum256(cdj)
+	digest := sha256.Sum256(append(append([]byte{}, authData...), hash[:]...))
+	er, es, err := ecdsa.Sign(rand.Reader, key, digest[:])
+	if err != nil {
+		return nil, err
+	}
+	sig, err := asn1.Marshal(struct{ R, S *big.Int }{er, es})
+	if err != nil {
+		return nil, err
+	}
+
+	r := webauthn.AssertionResponse{
+		ID:    webauthn.Encode(id),
+		RawID: webauthn.Encode(id),
+		Type:  "public-key",
+	}
+	r.Response.ClientDataJSON = webauthn.Encode(cdj)
+	r.Response.AuthenticatorData = webauthn.Encode(authData)
+	r.Response.Signature = webauthn.Encode(sig)
+	r.Response.UserHandle = webauthn.Encode(userHandle)
+	return json.Marshal(r)
+}
+
+func (a *Authenticator) clientData(typ, challenge string) ([]byte, error) {
+	return json.Marshal(map[string]interface{}{
+		"type":        typ,
+		"challenge":   challenge,
+		"origin":      a.Origin,
+		"crossOrigin": false,
+	})
+}
+
+func (a *Authenticator) authData(flags byte, count uint32) []byte {
+	hash := sha256.Sum256([]byte(a.RPID))
+	flags |= 0x01
+	if a.UserVerified {
+		flags |= 0x04
+	}
+	b := append(hash[:], flags, 0, 0, 0, 0)
+	binary.BigEndian.PutUint32(b[33:], count)
+	return b
+}
+
+// coseKey encodes pub as an ES256 COSE_Key
+func coseKey(pub *ecdsa.PublicKey) []byte {
+	x := make([]byte, 32)
+	y := make([]byte, 32)
+	xb, yb := pub.X.Bytes(), pub.Y.Bytes()
+	copy(x[32-len(xb):], xb)
+	copy(y[32-len(yb):], yb)
+	return encodeMap([]interface{}{
+		int64(1), int64(2),
+		int64(3), int64(-7),
+		int64(-1), int64(1),
+		int64(-2), x,
+		int64(-3), y,
+	})
+}
+
+// encodeMap encodes alternating keys and values as a CBOR map. Values may be
+// int64, string, []byte or nested key/value lists.
+func encodeMap(kv []interface{}) []byte {
+	b := encodeHead(5, uint64(len(kv)/2))
+	for i := 0; i < len(kv); i += 2 {
+		b = append(b, encode(kv[i])...)
+		b = append(b, encode(kv[i+1])...)
+	}
+	return b
+}
+
+func encode(v interface{}) []byte {
+	switch v := v.(type) {
+	case int64:
+		if v < 0 {
+			return encodeHead(1, uint64(-1-v))
+		}
+		return encodeHead(0, uint64(v))
+	case string:
+		return append(encodeHead(3, uint64(len(v))), v...)
+	case []byte:
+		return append(encodeHead(2, uint64(len(v))), v...)
+	case []interface{}:
+		return encodeMap(v)
+	}
+	panic("webauthntest: unsupported cbor value")
+}
+
+func encodeHead(major byte, n uint64) []byte {
+	m := major << 5
+	switch {
+	case n < 24:
+		return []byte{m | byte(n)}
+	case n <= 0xff:
+		return []byte{m | 24, byte(n)}
+	case n <= 0xffff:
+		b := []byte{m | 25, 0, 0}
+		binary.BigEndian.PutUint16(b[1:], uint16(n))
+		return b
+	case n <= 0xffffffff:
+		b := []byte{m | 26, 0, 0, 0, 0}
+		binary.BigEndian.PutUint32(b[1:], uint32(n))
+		return b
+	}
+	b := []byte{m | 27, 0, 0, 0, 0, 0, 0, 0, 0}
+	binary.BigEndian.PutUint64(b[1:], n)
+	return b
+}